@@ -0,0 +1,19 @@
+package auth
+
+import (
+	"errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var ErrWrongPassword = errors.New("wrong password")
+
+func HashPassword(password string) ([]byte, error) {
+	return bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+}
+
+func CheckPassword(u *User, password string) error {
+	if err := bcrypt.CompareHashAndPassword(u.PasswordHash, []byte(password)); err != nil {
+		return ErrWrongPassword
+	}
+	return nil
+}
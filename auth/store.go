@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+)
+
+// MemoryUserStore keeps users in memory, accounts are lost on restart
+type MemoryUserStore struct {
+	users map[string]*User
+	mu    sync.RWMutex
+}
+
+func NewMemoryUserStore() *MemoryUserStore {
+	return &MemoryUserStore{
+		users: make(map[string]*User),
+	}
+}
+
+func (s *MemoryUserStore) GetUser(name string) (*User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u, ok := s.users[name]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	return u, nil
+}
+
+func (s *MemoryUserStore) CreateUser(u *User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.users[u.Name]; ok {
+		return ErrUserExists
+	}
+	s.users[u.Name] = u
+	return nil
+}
+
+// FileUserStore keeps users in a json file, the whole file is rewritten on every change
+type FileUserStore struct {
+	MemoryUserStore
+	path string
+}
+
+func NewFileUserStore(path string) (*FileUserStore, error) {
+	s := &FileUserStore{
+		MemoryUserStore: MemoryUserStore{users: make(map[string]*User)},
+		path:            path,
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var users []*User
+	if err = json.Unmarshal(data, &users); err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		s.users[u.Name] = u
+	}
+	return s, nil
+}
+
+func (s *FileUserStore) CreateUser(u *User) error {
+	if err := s.MemoryUserStore.CreateUser(u); err != nil {
+		return err
+	}
+	if err := s.save(); err != nil {
+		s.mu.Lock()
+		delete(s.users, u.Name)
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (s *FileUserStore) save() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	users := make([]*User, 0, len(s.users))
+	for _, v := range s.users {
+		users = append(users, v)
+	}
+	data, err := json.MarshalIndent(users, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"os"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrTokenExpired = errors.New("token expired")
+)
+
+// Claims is the payload of a session token
+type Claims struct {
+	Session    uuid.UUID `json:"sid"`
+	PlayerName string    `json:"name"`
+	ExpiresAt  int64     `json:"exp"`
+}
+
+// Signer issues and verifies HMAC-SHA256 signed session tokens,
+// a token looks like base64(claims json).base64(signature)
+type Signer struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// NewSigner creates a Signer, a random secret is generated if secret is empty,
+// which means tokens will not survive a server restart
+func NewSigner(secret []byte, ttl time.Duration) (*Signer, error) {
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, err
+		}
+	}
+	return &Signer{
+		secret: secret,
+		ttl:    ttl,
+	}, nil
+}
+
+// LoadOrCreateSecret reads the token secret kept in the file, a random one is
+// written to the file first if it doesn't exist so tokens survive a restart
+func LoadOrCreateSecret(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		return base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+	secret := make([]byte, 32)
+	if _, err = rand.Read(secret); err != nil {
+		return nil, err
+	}
+	if err = os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(secret)+"\n"), 0600); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// Sign issues a token for the session which expires after the signer's ttl
+func (s *Signer) Sign(session uuid.UUID, playerName string) (string, *Claims, error) {
+	claims := &Claims{
+		Session:    session,
+		PlayerName: playerName,
+		ExpiresAt:  time.Now().Add(s.ttl).Unix(),
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", nil, err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.sign(encoded)), claims, nil
+}
+
+func (s *Signer) Verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, ErrInvalidToken
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(sig, s.sign(parts[0])) {
+		return nil, ErrInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	claims := new(Claims)
+	if err = json.Unmarshal(payload, claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if time.Now().Unix() >= claims.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+	return claims, nil
+}
+
+func (s *Signer) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+	ErrUserExists   = errors.New("user already exists")
+)
+
+type User struct {
+	Name         string    `json:"name"`
+	PasswordHash []byte    `json:"password_hash"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// UserStore keeps registered accounts, implementations must be safe for concurrent use
+type UserStore interface {
+	GetUser(name string) (*User, error)
+	CreateUser(u *User) error
+}
@@ -2,47 +2,26 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"github.com/hphphp123321/mahjong-goserver/client/v1"
+	"github.com/hphphp123321/mahjong-goserver/config"
 	"github.com/hphphp123321/mahjong-goserver/osutils"
+	"github.com/hphphp123321/mahjong-goserver/protocol"
 	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	"github.com/hphphp123321/mahjong-goserver/tlsutil"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
 	"os"
-	"path"
-	"runtime"
-	"strconv"
 	"time"
 )
 
-var (
-	playerName string
-	address    string
-	port       int
-	timeout    int
-	timeTicker int
-	logFormat  string
-	logLevel   string
-	logOutput  string
-	logFile    string
-)
-
-func parseFlags() {
-	flag.StringVar(&playerName, "playerName", "player2", "player name")
-	flag.StringVar(&address, "address", "127.0.0.1", "server address")
-	flag.IntVar(&port, "port", 16548, "port")
-	flag.IntVar(&timeout, "timeout", 5, "seconds for timeout")
-	flag.IntVar(&timeTicker, "timeTicker", 10, "seconds for time ticker")
-	flag.StringVar(&logFormat, "logFormat", "text", "log format(json or text)")
-	flag.StringVar(&logLevel, "logLevel", "debug", "log level(debug, info, warn, error, fatal, panic)")
-	flag.StringVar(&logOutput, "logOutput", "stdout", "log output(stdout or stderr)")
-	flag.StringVar(&logFile, "logFile", "", "log file path")
-	flag.Parse()
-}
-
 // unaryInterceptor 一个简单的 unary interceptor 示例。
 func unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 	// pre-processing
@@ -55,91 +34,73 @@ func unaryInterceptor(ctx context.Context, method string, req, reply interface{}
 	return err
 }
 
-func setupLogger() {
-	switch logFormat {
-	case "text":
-		log.SetFormatter(&log.TextFormatter{
-			ForceColors:               true,
-			TimestampFormat:           "2006-01-02 15:04:05",
-			FullTimestamp:             true,
-			EnvironmentOverrideColors: true,
-			CallerPrettyfier: func(frame *runtime.Frame) (function string, file string) {
-				//处理文件名
-				fileName := path.Base(frame.File)
-				return ": " + strconv.Itoa(frame.Line), fileName
-			},
-		})
-	case "json":
-		log.SetFormatter(&log.JSONFormatter{})
-	default:
-		log.Error("set log format error")
-	}
-
-	switch logLevel {
-	case "debug":
-		log.SetLevel(log.DebugLevel)
-		log.SetReportCaller(true)
-	case "info":
-		log.SetLevel(log.InfoLevel)
-	case "warn":
-		log.SetLevel(log.WarnLevel)
-	case "error":
-		log.SetLevel(log.ErrorLevel)
-	case "fatal":
-		log.SetLevel(log.FatalLevel)
-	default:
-		log.Error("set log level error")
-	}
-
-	switch logOutput {
-	case "stdout":
-		log.SetOutput(os.Stdout)
-	case "stderr":
-		log.SetOutput(os.Stderr)
-	default:
-		log.Error("set log output error")
-	}
-
-	if logFile != "" {
-		f, err := os.OpenFile(logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
-		if err == nil {
-			log.SetOutput(f)
-		} else {
-			log.Error("set log file error")
+func main() {
+	cfg := config.DefaultClient()
+	if err := config.Load(os.Args[0], os.Args[1:], cfg); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
 		}
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if err := cfg.Log.Setup(); err != nil {
+		log.Fatalf("failed to set up logging: %v", err)
 	}
-
-}
-
-func main() {
-	parseFlags()
-	setupLogger()
 	log.Debug("Hello World!")
 
 	var kacp = keepalive.ClientParameters{
-		Time:                time.Duration(timeTicker),
-		Timeout:             time.Duration(timeout),
+		Time:                time.Duration(cfg.Keepalive.Time) * time.Second,
+		Timeout:             time.Duration(cfg.Keepalive.Timeout) * time.Second,
 		PermitWithoutStream: true,
 	}
 
-	tcpAddr := fmt.Sprintf("%s:%d", address, port)
+	creds := insecure.NewCredentials()
+	if cfg.TLS.Enabled {
+		tlsConfig, err := tlsutil.ClientConfig(cfg.TLS.CAFile, cfg.TLS.ServerName, cfg.TLS.CertFile, cfg.TLS.KeyFile)
+		if err != nil {
+			log.Fatalf("failed to set up TLS: %v", err)
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	tcpAddr := cfg.Addr()
 	log.Debug("Start dial tcpAddr: ", tcpAddr)
-	conn, err := grpc.Dial(tcpAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithKeepaliveParams(kacp))
+	conn, err := grpc.Dial(tcpAddr, grpc.WithTransportCredentials(creds), grpc.WithKeepaliveParams(kacp))
 
 	//conn, err := grpc.Dial(tcpAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(unaryInterceptor), grpc.WithKeepaliveParams(kacp))
-	defer conn.Close()
 	if err != nil {
 		log.Fatalf("can not dial: %v", err)
 	}
+	defer conn.Close()
 
 	MahjongClient := pb.NewMahjongClient(conn)
 	//ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
 	//defer cancel()
 	ctx := context.Background()
-	c := v1.NewMahjongClient(ctx, playerName, MahjongClient)
+	c := v1.NewMahjongClient(ctx, cfg.PlayerName, cfg.Password, MahjongClient)
+
+	// Hello
+	if err = c.Hello("mahjong-goserver-client", protocol.FeatureReconnect); err != nil {
+		log.Fatalf("Hello failed: %v", err)
+	}
+
+	// Register
+	if cfg.Register {
+		err = c.Register()
+		if err != nil {
+			log.Fatalf("Register failed: %v", err)
+		}
+	}
 
 	// Login
 	err = c.Login()
+	if status.Code(err) == codes.NotFound {
+		log.Infof("account %s not found, register it", cfg.PlayerName)
+		if err = c.Register(); err != nil {
+			log.Fatalf("Register failed: %v", err)
+		}
+		err = c.Login()
+	}
 	if err != nil {
 		log.Fatalf("Login failed: %v", err)
 	}
@@ -159,13 +120,13 @@ func main() {
 	defer func() {
 		err := c.Logout()
 		if err != nil {
-			log.Warning("Logout failed: %v", err)
+			log.Warningf("Logout failed: %v", err)
 		}
 	}()
 
 	// ping
 	go func() {
-		ticker := time.NewTicker(time.Duration(timeTicker) * time.Second)
+		ticker := time.NewTicker(time.Duration(cfg.Keepalive.Time) * time.Second)
 		for {
 			select {
 			case <-ticker.C:
@@ -173,21 +134,44 @@ func main() {
 				if err != nil {
 					log.Fatalf("Ping failed: %v", err)
 				}
+				if time.Until(c.ExpiresAt) < 2*time.Duration(cfg.Keepalive.Time)*time.Second {
+					if err := c.RefreshToken(); err != nil {
+						log.Warningf("RefreshToken failed: %v", err)
+					}
+				}
 			}
 		}
 	}()
 
+	// JoinRoomByCode
+	if cfg.InviteCode != "" {
+		err = c.JoinRoomByCode(cfg.InviteCode)
+		if err != nil {
+			log.Warningf("could not join room by code: %v", err)
+		}
+	}
+
+	// JoinQueue
+	if c.Room == nil && cfg.Queue {
+		err = c.JoinQueue(cfg.RuleSet, true)
+		if err != nil {
+			log.Warningf("could not join queue: %v", err)
+		}
+	}
+
 	// RefreshRoom
-	err = c.RefreshRoom("")
-	if err != nil {
-		log.Warning("could not refresh room: %v", err)
+	if c.Room == nil {
+		err = c.RefreshRoom("")
+		if err != nil {
+			log.Warningf("could not refresh room: %v", err)
+		}
 	}
 
 	// JoinRoom
-	if len(c.RoomList) > 0 {
+	if c.Room == nil && len(c.RoomList) > 0 {
 		err = c.JoinRoom(c.RoomList[0].RoomID.String())
 		if err != nil {
-			log.Warning("could not join room: %v", err)
+			log.Warningf("could not join room: %v", err)
 		}
 	}
 
@@ -195,13 +179,18 @@ func main() {
 	if c.Room == nil {
 		err = c.CreateRoom("room1")
 		if err != nil {
-			log.Warning("could not create room: %v", err)
+			log.Warningf("could not create room: %v", err)
 		}
 	}
 
 	err = c.Ready()
 	if err != nil {
-		log.Warning("could not ready: %v", err)
+		log.Warningf("could not ready: %v", err)
+	}
+
+	err = c.Start()
+	if err != nil {
+		log.Warningf("start stream closed: %v", err)
 	}
 
 }
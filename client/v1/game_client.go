@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"github.com/hphphp123321/mahjong-goserver/player"
+	"github.com/hphphp123321/mahjong-goserver/protocol"
 	"github.com/hphphp123321/mahjong-goserver/room"
 	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
 	log "github.com/sirupsen/logrus"
@@ -21,17 +22,23 @@ type MahjongClient struct {
 	Delay time.Duration
 	Ctx   context.Context
 
-	P *player.Player
+	P         *player.Player
+	Password  string
+	Token     string
+	ExpiresAt time.Time
+	// Features are the features the server agreed to in Hello
+	Features []string
 
 	RoomList []*room.Room
 	Room     *room.Room
 }
 
-func NewMahjongClient(ctx context.Context, playerName string, grpcClient pb.MahjongClient) *MahjongClient {
+func NewMahjongClient(ctx context.Context, playerName string, password string, grpcClient pb.MahjongClient) *MahjongClient {
 	return &MahjongClient{
-		Client: grpcClient,
-		P:      player.NewPlayer(playerName, uuid.Nil),
-		Ctx:    ctx,
+		Client:   grpcClient,
+		P:        player.NewPlayer(playerName, uuid.Nil),
+		Password: password,
+		Ctx:      metadata.AppendToOutgoingContext(ctx, protocol.Header, protocol.Current.String()),
 	}
 }
 
@@ -48,18 +55,49 @@ func (c *MahjongClient) Ping() error {
 	return nil
 }
 
+// Hello tells the server the protocol of the client and the features it wants, it fails with
+// what to do about it if the server can't serve this client
+func (c *MahjongClient) Hello(clientName string, features ...string) error {
+	helloReply, err := c.Client.Hello(c.Ctx, &pb.HelloRequest{
+		ProtocolVersion: protocol.Current.String(),
+		ClientName:      clientName,
+		Features:        features,
+	})
+	if err != nil {
+		return err
+	}
+	c.Features = helloReply.Features
+	log.Printf("Hello: %s, server protocol: %s", helloReply.Message, helloReply.ProtocolVersion)
+	if helloReply.Support == pb.ProtocolSupport_ProtocolDeprecated {
+		log.Warnf("protocol %s is deprecated, update the client", protocol.Current)
+	}
+	return nil
+}
+
+func (c *MahjongClient) Register() error {
+	log.Printf("Start Register: playerName: %s", c.P.PlayerName)
+	registerReply, err := c.Client.Register(c.Ctx, &pb.RegisterRequest{
+		PlayerName: c.P.PlayerName,
+		Password:   c.Password,
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("Register: %s", registerReply.Message)
+	return nil
+}
+
 func (c *MahjongClient) Login() error {
 	log.Printf("Start Login: playerName: %s", c.P.PlayerName)
 	loginReply, err := c.Client.Login(c.Ctx, &pb.LoginRequest{
 		PlayerName: c.P.PlayerName,
+		Password:   c.Password,
 	})
 	if err != nil {
 		return err
 	}
-	c.P.Token = uuid.MustParse(loginReply.Token)
-	log.Printf("Login: %s, UUID: %s", loginReply.Message, loginReply.Token)
-	header := metadata.New(map[string]string{"token": loginReply.Token})
-	c.Ctx = metadata.NewOutgoingContext(c.Ctx, header)
+	c.setToken(loginReply.Token, loginReply.ExpiresAt)
+	log.Printf("Login: %s, expires at: %s", loginReply.Message, c.ExpiresAt)
 
 	c.StartStream, err = c.Client.Start(c.Ctx)
 	if err != nil {
@@ -68,6 +106,24 @@ func (c *MahjongClient) Login() error {
 	return nil
 }
 
+// RefreshToken gets a new token for the current session before the old one expires
+func (c *MahjongClient) RefreshToken() error {
+	refreshReply, err := c.Client.RefreshToken(c.Ctx, &pb.Empty{})
+	if err != nil {
+		return err
+	}
+	c.setToken(refreshReply.Token, refreshReply.ExpiresAt)
+	log.Printf("RefreshToken: %s, expires at: %s", refreshReply.Message, c.ExpiresAt)
+	return nil
+}
+
+func (c *MahjongClient) setToken(token string, expiresAt int64) {
+	c.Token = token
+	c.ExpiresAt = time.Unix(expiresAt, 0)
+	header := metadata.New(map[string]string{"token": token, protocol.Header: protocol.Current.String()})
+	c.Ctx = metadata.NewOutgoingContext(c.Ctx, header)
+}
+
 func (c *MahjongClient) Logout() error {
 	log.Printf("Start Logout: playerName: %s", c.P.PlayerName)
 	c.ReadyStream.CloseSend()
@@ -101,6 +157,69 @@ func (c *MahjongClient) RefreshRoom(roomName string) error {
 	return nil
 }
 
+// WatchLobby keeps RoomList up to date with the lobby until the context of the client is done,
+// onChange is called after every reply if it is not nil
+func (c *MahjongClient) WatchLobby(roomName string, onChange func(reply *pb.LobbyReply)) error {
+	log.Printf("Start WatchLobby: playerName: %s", c.P.PlayerName)
+	lobbyStream, err := c.Client.WatchLobby(c.Ctx, &pb.WatchLobbyRequest{RoomName: &roomName})
+	if err != nil {
+		return err
+	}
+	for {
+		lobbyReply, err := lobbyStream.Recv()
+		if err != nil {
+			return err
+		}
+		log.Debugf("WatchLobby: %s", lobbyReply.Message)
+		switch lobbyReply.GetReply().(type) {
+		case *pb.LobbyReply_Snapshot:
+			c.RoomList = make([]*room.Room, 0)
+			for _, r := range lobbyReply.GetSnapshot().Rooms {
+				if err = c.setLobbyRoom(r); err != nil {
+					return err
+				}
+			}
+		case *pb.LobbyReply_RoomCreated:
+			err = c.setLobbyRoom(lobbyReply.GetRoomCreated())
+		case *pb.LobbyReply_PlayerCountChanged:
+			err = c.setLobbyRoom(lobbyReply.GetPlayerCountChanged())
+		case *pb.LobbyReply_StatusChanged:
+			err = c.setLobbyRoom(lobbyReply.GetStatusChanged())
+		case *pb.LobbyReply_RoomClosed:
+			for i, r := range c.RoomList {
+				if r.RoomID.String() == lobbyReply.GetRoomClosed().RoomID {
+					c.RoomList = append(c.RoomList[:i], c.RoomList[i+1:]...)
+					break
+				}
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if onChange != nil {
+			onChange(lobbyReply)
+		}
+	}
+}
+
+// setLobbyRoom adds the room to RoomList or updates it
+func (c *MahjongClient) setLobbyRoom(r *pb.Room) error {
+	roomID, err := uuid.Parse(r.RoomID)
+	if err != nil {
+		return err
+	}
+	for _, rn := range c.RoomList {
+		if rn.RoomID == roomID {
+			rn.PlayerCount = int(r.PlayerCount)
+			return nil
+		}
+	}
+	rn := room.NewRoom(roomID, r.RoomName, player.NewPlayer(r.OwnerName, uuid.Nil))
+	rn.PlayerCount = int(r.PlayerCount)
+	c.RoomList = append(c.RoomList, rn)
+	return nil
+}
+
 func (c *MahjongClient) CreateRoom(roomName string) error {
 	log.Printf("Start CreateRoom: playerName: %s", c.P.PlayerName)
 	createRoomReply, err := c.Client.CreateRoom(c.Ctx, &pb.CreateRoomRequest{
@@ -115,7 +234,9 @@ func (c *MahjongClient) CreateRoom(roomName string) error {
 	}
 	c.Room = room.NewRoom(roomID, createRoomReply.Room.RoomName, player.NewPlayer(createRoomReply.Room.OwnerName, uuid.Nil))
 	c.Room.PlayerCount = int(createRoomReply.Room.PlayerCount)
-	log.Printf("CreateRoom: %s", createRoomReply.Message)
+	c.Room.Private = createRoomReply.Room.Private
+	c.Room.InviteCode = createRoomReply.InviteCode
+	log.Printf("CreateRoom: %s, invite code: %s", createRoomReply.Message, c.Room.InviteCode)
 	c.ReadyStream, err = c.Client.Ready(c.Ctx)
 	if err != nil {
 		return err
@@ -126,9 +247,30 @@ func (c *MahjongClient) CreateRoom(roomName string) error {
 
 func (c *MahjongClient) JoinRoom(roomId string) error {
 	log.Printf("Start JoinRoom: roomID: %s", roomId)
-	joinRoomReply, err := c.Client.JoinRoom(c.Ctx, &pb.JoinRoomRequest{
+	return c.joinRoom(&pb.JoinRoomRequest{
 		RoomID: roomId,
 	})
+}
+
+// JoinRoomByCode joins a private room with the invite code shared by its players
+func (c *MahjongClient) JoinRoomByCode(inviteCode string) error {
+	log.Printf("Start JoinRoom: inviteCode: %s", inviteCode)
+	return c.joinRoom(&pb.JoinRoomRequest{
+		InviteCode: &inviteCode,
+	})
+}
+
+// JoinRoomWithPassword joins a room protected by a password
+func (c *MahjongClient) JoinRoomWithPassword(roomId string, password string) error {
+	log.Printf("Start JoinRoom: roomID: %s", roomId)
+	return c.joinRoom(&pb.JoinRoomRequest{
+		RoomID:   roomId,
+		Password: &password,
+	})
+}
+
+func (c *MahjongClient) joinRoom(req *pb.JoinRoomRequest) error {
+	joinRoomReply, err := c.Client.JoinRoom(c.Ctx, req)
 	if err != nil {
 		return err
 	}
@@ -138,6 +280,8 @@ func (c *MahjongClient) JoinRoom(roomId string) error {
 	}
 	c.Room = room.NewRoom(roomID, joinRoomReply.Room.RoomName, player.NewPlayer(joinRoomReply.Room.OwnerName, uuid.Nil))
 	c.Room.PlayerCount = int(joinRoomReply.Room.PlayerCount)
+	c.Room.Private = joinRoomReply.Room.Private
+	c.Room.InviteCode = joinRoomReply.InviteCode
 	c.P.Seat = int(joinRoomReply.Seat)
 	log.Printf("JoinRoom: %s", joinRoomReply.Message)
 	c.ReadyStream, err = c.Client.Ready(c.Ctx)
@@ -148,14 +292,97 @@ func (c *MahjongClient) JoinRoom(roomId string) error {
 	return nil
 }
 
+// JoinQueue waits in the matchmaking queue until the server seats the player in a room,
+// then opens the ready stream of that room
+func (c *MahjongClient) JoinQueue(ruleSet string, allowRobots bool) error {
+	log.Printf("Start JoinQueue: playerName: %s", c.P.PlayerName)
+	req := &pb.JoinQueueRequest{AllowRobots: allowRobots}
+	if ruleSet != "" {
+		req.RuleSet = &ruleSet
+	}
+	queueStream, err := c.Client.JoinQueue(c.Ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		queueReply, err := queueStream.Recv()
+		if err != nil {
+			return err
+		}
+		log.Printf("JoinQueue: %s", queueReply.Message)
+		switch queueReply.GetReply().(type) {
+		case *pb.QueueReply_Left:
+			return nil
+		case *pb.QueueReply_Matched:
+			matched := queueReply.GetMatched()
+			roomID, err := uuid.Parse(matched.Room.RoomID)
+			if err != nil {
+				return err
+			}
+			c.Room = room.NewRoom(roomID, matched.Room.RoomName, player.NewPlayer(matched.Room.OwnerName, uuid.Nil))
+			c.Room.PlayerCount = int(matched.Room.PlayerCount)
+			c.P.Seat = int(matched.Seat)
+			c.ReadyStream, err = c.Client.Ready(c.Ctx)
+			if err != nil {
+				return err
+			}
+			log.Printf("Start ReadyStream")
+			return nil
+		}
+	}
+}
+
+func (c *MahjongClient) LeaveQueue() error {
+	leaveQueueReply, err := c.Client.LeaveQueue(c.Ctx, &pb.Empty{})
+	if err != nil {
+		return err
+	}
+	log.Printf("LeaveQueue: %s", leaveQueueReply.Message)
+	return nil
+}
+
+// GetSessionSummary returns the session table of the current room, export is filled for CSV or JSON
+func (c *MahjongClient) GetSessionSummary(export pb.ExportFormat) (*pb.GetSessionSummaryReply, error) {
+	summaryReply, err := c.Client.GetSessionSummary(c.Ctx, &pb.GetSessionSummaryRequest{Export: export})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("GetSessionSummary: %s", summaryReply.Message)
+	return summaryReply, nil
+}
+
+func (c *MahjongClient) RegisterTournament(tournamentID string) error {
+	tournamentReply, err := c.Client.RegisterTournament(c.Ctx, &pb.TournamentRequest{TournamentID: tournamentID})
+	if err != nil {
+		return err
+	}
+	log.Printf("RegisterTournament: %s", tournamentReply.Message)
+	return nil
+}
+
+// GetTournament returns the standings and the tables of the current round,
+// the room of the player is listed in the tables
+func (c *MahjongClient) GetTournament(tournamentID string) (*pb.Tournament, error) {
+	tournamentReply, err := c.Client.GetTournament(c.Ctx, &pb.TournamentRequest{TournamentID: tournamentID})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("GetTournament: %s", tournamentReply.Message)
+	return tournamentReply.Tournament, nil
+}
+
+// Ready gets ready in the room until the game starts, the owner starts the game
+// as soon as the server accepts it
 func (c *MahjongClient) Ready() error {
 	var err error
 	var wg sync.WaitGroup
+	done := make(chan struct{})
 
 	// Receive
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
+		defer close(done)
 		for {
 			readyReply, err := c.ReadyStream.Recv()
 			if err == io.EOF {
@@ -168,6 +395,19 @@ func (c *MahjongClient) Ready() error {
 			switch readyReply.GetReply().(type) {
 			case *pb.ReadyReply_GetReady:
 				c.handleGetReadyReply(readyReply)
+			case *pb.ReadyReply_SwapSeats:
+				c.handleSwapSeatsReply(readyReply)
+			case *pb.ReadyReply_MoveSeat:
+				c.handleMoveSeatReply(readyReply)
+			case *pb.ReadyReply_TransferOwner:
+				c.Room.Owner = player.NewPlayer(readyReply.GetTransferOwner().OwnerName, uuid.Nil)
+			case *pb.ReadyReply_KickPlayer:
+				if readyReply.GetKickPlayer().PlayerName == c.P.PlayerName {
+					c.Room = nil
+					return
+				}
+			case *pb.ReadyReply_StartGame:
+				return
 			}
 		}
 	}()
@@ -177,17 +417,28 @@ func (c *MahjongClient) Ready() error {
 	go func() {
 		defer wg.Done()
 		defer c.ReadyStream.CloseSend()
+		ticker := time.NewTicker(1 * time.Second)
+		defer ticker.Stop()
 		for {
-			if !c.P.Ready {
-				log.Printf("send ready")
-				err = c.ReadyStream.Send(&pb.ReadyRequest{
-					Request: &pb.ReadyRequest_GetReady{
-						GetReady: &pb.Empty{}}})
-				if err != nil {
+			req := &pb.ReadyRequest{Request: &pb.ReadyRequest_GetReady{GetReady: &pb.Empty{}}}
+			if c.P.Ready {
+				if c.Room == nil || c.Room.Owner.PlayerName != c.P.PlayerName {
+					req = nil
+				} else {
+					req = &pb.ReadyRequest{Request: &pb.ReadyRequest_StartGame{StartGame: &pb.Empty{}}}
+				}
+			}
+			if req != nil {
+				log.Printf("send %s", req.String())
+				if err = c.ReadyStream.Send(req); err != nil {
 					log.Printf("ReadyStream.Send: %s", err)
 					return
 				}
-				time.Sleep(1 * time.Second)
+			}
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
 			}
 		}
 	}()
@@ -199,8 +450,53 @@ func (c *MahjongClient) Ready() error {
 	return nil
 }
 
+// Start receives the game messages of the room until the stream ends
+func (c *MahjongClient) Start() error {
+	for {
+		startReply, err := c.StartStream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		log.Printf("StartStream.Recv: %s", startReply.Message)
+		switch startReply.GetReply().(type) {
+		case *pb.StartReply_SeatDraw:
+			c.handleSeatDrawReply(startReply)
+		}
+	}
+}
+
+func (c *MahjongClient) handleSeatDrawReply(startReply *pb.StartReply) {
+	for _, seat := range startReply.GetSeatDraw().Seats {
+		if seat.PlayerName == c.P.PlayerName {
+			c.P.Seat = int(seat.Seat)
+			log.Printf("SeatDraw: seat %d, wind %s, seed %d", seat.Seat, seat.Wind, startReply.GetSeatDraw().Seed)
+		}
+	}
+}
+
 func (c *MahjongClient) handleGetReadyReply(readyReply *pb.ReadyReply) {
 	if int(readyReply.GetGetReady().Seat) == c.P.Seat {
 		c.P.Ready = true
 	}
 }
+
+// handleSwapSeatsReply follows the owner moving this player to another seat
+func (c *MahjongClient) handleSwapSeatsReply(readyReply *pb.ReadyReply) {
+	swap := readyReply.GetSwapSeats()
+	switch c.P.Seat {
+	case int(swap.SeatA):
+		c.P.Seat = int(swap.SeatB)
+	case int(swap.SeatB):
+		c.P.Seat = int(swap.SeatA)
+	}
+}
+
+func (c *MahjongClient) handleMoveSeatReply(readyReply *pb.ReadyReply) {
+	move := readyReply.GetMoveSeat()
+	if move.PlayerName == c.P.PlayerName {
+		c.P.Seat = int(move.ToSeat)
+	}
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+)
+
+// Client is the configuration of the example client binary
+type Client struct {
+	PlayerName string          `yaml:"playerName"`
+	Password   string          `yaml:"password"`
+	Register   bool            `yaml:"register"`
+	Queue      bool            `yaml:"queue"`
+	RuleSet    string          `yaml:"ruleSet"`
+	InviteCode string          `yaml:"inviteCode"`
+	Server     Listen          `yaml:"server"`
+	Keepalive  ClientKeepalive `yaml:"keepalive"`
+	TLS        ClientTLS       `yaml:"tls"`
+	Log        Log             `yaml:"log"`
+}
+
+// ClientKeepalive is in seconds
+type ClientKeepalive struct {
+	Time    int `yaml:"time"`
+	Timeout int `yaml:"timeout"`
+}
+
+func DefaultClient() *Client {
+	return &Client{
+		PlayerName: "player2",
+		Server:     Listen{Address: "127.0.0.1", Port: 16548},
+		Keepalive:  ClientKeepalive{Time: 10, Timeout: 5},
+		Log:        defaultLog(),
+	}
+}
+
+func (c *Client) Bind(fs *flag.FlagSet) {
+	fs.StringVar(&c.PlayerName, "playerName", c.PlayerName, "player name")
+	fs.StringVar(&c.Password, "password", c.Password, "account password")
+	fs.BoolVar(&c.Register, "register", c.Register, "register the account before login")
+	fs.BoolVar(&c.Queue, "queue", c.Queue, "join the matchmaking queue instead of picking a room")
+	fs.StringVar(&c.RuleSet, "ruleSet", c.RuleSet, "rule set preset to queue for")
+	fs.StringVar(&c.InviteCode, "inviteCode", c.InviteCode, "invite code of a private room to join")
+	fs.StringVar(&c.Server.Address, "address", c.Server.Address, "server address")
+	fs.IntVar(&c.Server.Port, "port", c.Server.Port, "port")
+	fs.IntVar(&c.Keepalive.Timeout, "timeout", c.Keepalive.Timeout, "seconds for timeout")
+	fs.IntVar(&c.Keepalive.Time, "timeTicker", c.Keepalive.Time, "seconds for time ticker")
+	c.TLS.bind(fs)
+	c.Log.bind(fs)
+}
+
+func (c *Client) Validate() error {
+	v := &validator{}
+	v.check(c.PlayerName != "", "playerName is required")
+	v.check(c.Server.Address != "", "server.address is required")
+	v.check(c.Server.Port > 0 && c.Server.Port < 65536, "server.port %d must be between 1 and 65535", c.Server.Port)
+	v.check(c.Keepalive.Time > 0, "keepalive.time %d must be at least 1 second", c.Keepalive.Time)
+	v.check(c.Keepalive.Timeout > 0, "keepalive.timeout %d must be at least 1 second", c.Keepalive.Timeout)
+	c.TLS.validate(v)
+	c.Log.validate(v)
+	return v.err()
+}
+
+// Addr returns the address of the server
+func (c *Client) Addr() string {
+	return fmt.Sprintf("%s:%d", c.Server.Address, c.Server.Port)
+}
@@ -0,0 +1,151 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// EnvPrefix starts the environment variable of every setting, maxClients is read from MAHJONG_MAX_CLIENTS
+const EnvPrefix = "MAHJONG_"
+
+// Config is a binary configuration that can be loaded by Load
+type Config interface {
+	// Bind defines a flag for every setting, bound to the field with its current value as default
+	Bind(fs *flag.FlagSet)
+	// Validate returns every problem of the configuration joined in one error
+	Validate() error
+}
+
+// Load fills cfg, which holds the defaults, from the config file, then the environment and then the flags,
+// later sources win. The file is given by -config or MAHJONG_CONFIG and is optional
+func Load(name string, args []string, cfg Config) error {
+	path := os.Getenv(EnvPrefix + "CONFIG")
+	if p, ok := configArg(args); ok {
+		path = p
+	}
+	if path != "" {
+		if err := loadFile(path, cfg); err != nil {
+			return err
+		}
+	}
+
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.String("config", path, "YAML config file, settings are overridden by MAHJONG_* environment variables and flags")
+	cfg.Bind(fs)
+	var errs []string
+	fs.VisitAll(func(f *flag.Flag) {
+		if f.Name == "config" {
+			return
+		}
+		key := EnvName(f.Name)
+		if v, ok := os.LookupEnv(key); ok {
+			if err := f.Value.Set(v); err != nil {
+				errs = append(errs, fmt.Sprintf("%s=%q: %v", key, v, err))
+			}
+		}
+	})
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid environment:\n  %s", strings.Join(errs, "\n  "))
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid config:\n  %w", err)
+	}
+	return nil
+}
+
+func loadFile(path string, cfg Config) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	// a misspelled key is reported instead of being silently ignored
+	dec.KnownFields(true)
+	if err = dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("parse config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// configArg finds -config in the arguments before the flags are parsed
+func configArg(args []string) (string, bool) {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		name := strings.TrimLeft(arg, "-")
+		if name == arg {
+			continue
+		}
+		if strings.HasPrefix(name, "config=") {
+			return strings.TrimPrefix(name, "config="), true
+		}
+		if name == "config" && i+1 < len(args) {
+			return args[i+1], true
+		}
+	}
+	return "", false
+}
+
+// EnvName returns the environment variable of a flag, maxClients becomes MAHJONG_MAX_CLIENTS
+func EnvName(flagName string) string {
+	var b strings.Builder
+	b.WriteString(EnvPrefix)
+	for i, r := range flagName {
+		if unicode.IsUpper(r) && i > 0 {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
+
+// validator collects the problems of a configuration
+type validator struct {
+	errs []string
+}
+
+func (v *validator) check(ok bool, format string, args ...interface{}) {
+	if !ok {
+		v.errs = append(v.errs, fmt.Sprintf(format, args...))
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(v.errs, "\n  "))
+}
+
+// stringList is a flag of comma separated values
+type stringList struct {
+	values *[]string
+}
+
+func (l stringList) String() string {
+	if l.values == nil {
+		return ""
+	}
+	return strings.Join(*l.values, ",")
+}
+
+func (l stringList) Set(s string) error {
+	*l.values = nil
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l.values = append(*l.values, v)
+		}
+	}
+	return nil
+}
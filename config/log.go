@@ -0,0 +1,100 @@
+package config
+
+import (
+	"flag"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	log "github.com/sirupsen/logrus"
+	"os"
+	"path"
+	"runtime"
+	"strconv"
+)
+
+// Log is the logging setup shared by the server and the client
+type Log struct {
+	Format string `yaml:"format"` // text or json
+	Level  string `yaml:"level"`  // debug, info, warn, error or fatal
+	Output string `yaml:"output"` // stdout or stderr
+	File   string `yaml:"file"`   // written instead of Output if set
+	// RoomDir gets a file per room with the lines about the room as well, server only
+	RoomDir string `yaml:"roomDir"`
+}
+
+func defaultLog() Log {
+	return Log{
+		Format: "text",
+		Level:  "debug",
+		Output: "stdout",
+	}
+}
+
+func (l *Log) bind(fs *flag.FlagSet) {
+	fs.StringVar(&l.Format, "logFormat", l.Format, "log format(json or text)")
+	fs.StringVar(&l.Level, "logLevel", l.Level, "log level(debug, info, warn, error, fatal, panic)")
+	fs.StringVar(&l.Output, "logOutput", l.Output, "log output(stdout or stderr)")
+	fs.StringVar(&l.File, "logFile", l.File, "log file path")
+}
+
+func (l *Log) validate(v *validator) {
+	v.check(oneOf(l.Format, "text", "json"), "log.format %q must be text or json", l.Format)
+	v.check(oneOf(l.Level, "debug", "info", "warn", "error", "fatal", "panic"),
+		"log.level %q must be debug, info, warn, error, fatal or panic", l.Level)
+	v.check(oneOf(l.Output, "stdout", "stderr"), "log.output %q must be stdout or stderr", l.Output)
+}
+
+func oneOf(s string, values ...string) bool {
+	for _, v := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Setup configures the standard logger, Validate has to pass first
+func (l *Log) Setup() error {
+	switch l.Format {
+	case "text":
+		log.SetFormatter(&log.TextFormatter{
+			ForceColors:               true,
+			TimestampFormat:           "2006-01-02 15:04:05",
+			FullTimestamp:             true,
+			EnvironmentOverrideColors: true,
+			CallerPrettyfier: func(frame *runtime.Frame) (function string, file string) {
+				//处理文件名
+				fileName := path.Base(frame.File)
+				return ": " + strconv.Itoa(frame.Line), fileName
+			},
+		})
+	case "json":
+		log.SetFormatter(&log.JSONFormatter{})
+	}
+
+	level, err := log.ParseLevel(l.Level)
+	if err != nil {
+		return err
+	}
+	// the level can be changed at runtime for the server or a single room
+	logging.Install(log.StandardLogger(), level)
+
+	switch l.Output {
+	case "stdout":
+		log.SetOutput(os.Stdout)
+	case "stderr":
+		log.SetOutput(os.Stderr)
+	}
+
+	if l.File != "" {
+		f, err := os.OpenFile(l.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+		if err != nil {
+			return err
+		}
+		log.SetOutput(f)
+	}
+	if l.RoomDir != "" {
+		if err = logging.SplitRooms(l.RoomDir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
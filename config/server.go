@@ -0,0 +1,278 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"github.com/hphphp123321/mahjong-goserver/protocol"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	"net"
+)
+
+// Server is the configuration of the game server binary
+type Server struct {
+	Listen    Listen          `yaml:"listen"`
+	Keepalive ServerKeepalive `yaml:"keepalive"`
+	TLS       ServerTLS       `yaml:"tls"`
+	Log       Log             `yaml:"log"`
+	Metrics   Metrics         `yaml:"metrics"`
+	Gateway   Gateway         `yaml:"gateway"`
+	Tracing   Tracing         `yaml:"tracing"`
+	Protocol  Protocol        `yaml:"protocol"`
+	Limits    Limits          `yaml:"limits"`
+	Storage   Storage         `yaml:"storage"`
+	Auth      Auth            `yaml:"auth"`
+	Rating    string          `yaml:"rating"` // elo or tenhou
+	Queue     Queue           `yaml:"queue"`
+	Shutdown  Shutdown        `yaml:"shutdown"`
+	RuleSets  []RuleSet       `yaml:"ruleSets"`
+	Robots    []Robot         `yaml:"robots"`
+}
+
+type Listen struct {
+	Address string `yaml:"address"`
+	Port    int    `yaml:"port"`
+}
+
+// ServerKeepalive is in seconds
+type ServerKeepalive struct {
+	MinTime               int `yaml:"minTime"`
+	MaxConnectionIdle     int `yaml:"maxConnectionIdle"`
+	MaxConnectionAgeGrace int `yaml:"maxConnectionAgeGrace"`
+	Time                  int `yaml:"time"`
+	Timeout               int `yaml:"timeout"`
+}
+
+type Limits struct {
+	MaxClients           int `yaml:"maxClients"`
+	RobotDecisionTimeout int `yaml:"robotDecisionTimeout"` // seconds, slower decisions are counted as timeouts but not interrupted, 0 counts none
+}
+
+// Tracing exports spans of RPCs and game events as JSON to stdout or a file, none records nothing
+type Tracing struct {
+	Exporter    string  `yaml:"exporter"` // none, stdout or file
+	File        string  `yaml:"file"`
+	SampleRatio float64 `yaml:"sampleRatio"` // share of traces kept, from 0 to 1
+}
+
+// Metrics serves /metrics over HTTP on Address, disabled if it is empty
+type Metrics struct {
+	Address string `yaml:"address"`
+}
+
+// Gateway serves the service to browsers as JSON over HTTP and WebSocket on Address, disabled if it is empty.
+// It uses the TLS certificate of the server when TLS is enabled
+type Gateway struct {
+	Address        string   `yaml:"address"`
+	AllowedOrigins []string `yaml:"allowedOrigins"` // origins of the web frontend, * allows every origin
+}
+
+// Protocol is the range of client protocol versions served, up to the protocol of this build
+type Protocol struct {
+	MinVersion string `yaml:"minVersion"` // like 1.0, older clients are refused
+}
+
+type Storage struct {
+	DBPath       string `yaml:"dbPath"`
+	UserFile     string `yaml:"userFile"`
+	SnapshotFile string `yaml:"snapshotFile"`
+}
+
+type Auth struct {
+	TokenSecret     string `yaml:"tokenSecret"`
+	TokenSecretFile string `yaml:"tokenSecretFile"`
+	TokenTTL        int    `yaml:"tokenTTL"` // minutes
+	AdminToken      string `yaml:"adminToken"`
+}
+
+// Queue is in seconds
+type Queue struct {
+	Widen      int    `yaml:"widen"`
+	RobotAfter int    `yaml:"robotAfter"`
+	RobotLevel string `yaml:"robotLevel"`
+}
+
+// Shutdown is in seconds
+type Shutdown struct {
+	DrainTimeout      int    `yaml:"drainTimeout"`
+	StopTimeout       int    `yaml:"stopTimeout"`
+	MaintenanceNotice string `yaml:"maintenanceNotice"`
+}
+
+// RuleSet is a preset rooms can be created with, the default scoring is used if the points are 0
+type RuleSet struct {
+	Name         string    `yaml:"name"`
+	SeatDraw     string    `yaml:"seatDraw"` // none, random, wind or rotate
+	StartPoints  int       `yaml:"startPoints"`
+	ReturnPoints int       `yaml:"returnPoints"`
+	Uma          []float64 `yaml:"uma"`
+}
+
+// Robot registers an existing robot under another name, so rooms can ask for Easy when it is Simple
+type Robot struct {
+	Name string `yaml:"name"`
+	Use  string `yaml:"use"`
+}
+
+func DefaultServer() *Server {
+	return &Server{
+		Listen: Listen{Address: "127.0.0.1", Port: 16548},
+		Keepalive: ServerKeepalive{
+			MinTime:               1,
+			MaxConnectionIdle:     15,
+			MaxConnectionAgeGrace: 5,
+			Time:                  10,
+			Timeout:               5,
+		},
+		Log:      defaultLog(),
+		Limits:   Limits{MaxClients: 10, RobotDecisionTimeout: 10},
+		Metrics:  Metrics{Address: "127.0.0.1:16549"},
+		Tracing:  Tracing{Exporter: "none", File: "mahjong.traces.json", SampleRatio: 1},
+		Protocol: Protocol{MinVersion: protocol.Matrix[0].Version.String()},
+		Storage: Storage{
+			DBPath:       "mahjong.db",
+			SnapshotFile: "mahjong.snapshot.json",
+		},
+		Auth: Auth{
+			TokenSecretFile: "mahjong.secret",
+			TokenTTL:        24 * 60,
+		},
+		Rating: "tenhou",
+		Queue:  Queue{Widen: 10, RobotAfter: 60, RobotLevel: "Simple"},
+		Shutdown: Shutdown{
+			DrainTimeout:      60,
+			StopTimeout:       10,
+			MaintenanceNotice: "the server is going down for maintenance, running games may finish",
+		},
+	}
+}
+
+func (c *Server) Bind(fs *flag.FlagSet) {
+	fs.IntVar(&c.Limits.MaxClients, "maxClients", c.Limits.MaxClients, "max clients")
+	fs.IntVar(&c.Limits.RobotDecisionTimeout, "robotDecisionTimeout", c.Limits.RobotDecisionTimeout, "seconds after which a robot decision is counted as a timeout in the metrics, the robot is not interrupted, 0 counts none")
+	fs.StringVar(&c.Listen.Address, "address", c.Listen.Address, "server address")
+	fs.IntVar(&c.Listen.Port, "port", c.Listen.Port, "port")
+
+	fs.IntVar(&c.Keepalive.MinTime, "minTime", c.Keepalive.MinTime, "If a client pings more than once every MinTime seconds, terminate the connection")
+	fs.IntVar(&c.Keepalive.MaxConnectionIdle, "maxConnectionIdle", c.Keepalive.MaxConnectionIdle, "If a client is idle for Idle seconds, send a GOAWAY")
+	fs.IntVar(&c.Keepalive.MaxConnectionAgeGrace, "maxConnectionAgeGrace", c.Keepalive.MaxConnectionAgeGrace, "Allow Grace seconds for pending RPCs to complete before forcibly closing connections")
+	fs.IntVar(&c.Keepalive.Time, "timeTick", c.Keepalive.Time, "Ping the client if it is idle for timeTick seconds to ensure the connection is still active")
+	fs.IntVar(&c.Keepalive.Timeout, "timeout", c.Keepalive.Timeout, "Wait timeout seconds for the ping ack before assuming the connection is dead")
+
+	c.TLS.bind(fs)
+	c.Log.bind(fs)
+	fs.StringVar(&c.Log.RoomDir, "logRoomDir", c.Log.RoomDir, "directory getting a log file per room, disabled if empty")
+	fs.StringVar(&c.Metrics.Address, "metricsAddress", c.Metrics.Address, "address serving /metrics over HTTP, disabled if empty")
+	fs.StringVar(&c.Gateway.Address, "gatewayAddress", c.Gateway.Address, "address serving the HTTP/JSON and WebSocket gateway, disabled if empty")
+	fs.Var(stringList{&c.Gateway.AllowedOrigins}, "gatewayAllowedOrigins", "comma separated origins allowed to call the gateway from a browser, * allows every origin")
+	fs.StringVar(&c.Tracing.Exporter, "traceExporter", c.Tracing.Exporter, "trace exporter(none, stdout or file)")
+	fs.StringVar(&c.Tracing.File, "traceFile", c.Tracing.File, "file traces are appended to by the file exporter")
+	fs.Float64Var(&c.Tracing.SampleRatio, "traceSampleRatio", c.Tracing.SampleRatio, "share of traces kept, from 0 to 1")
+	fs.StringVar(&c.Protocol.MinVersion, "protocolMinVersion", c.Protocol.MinVersion, "oldest client protocol version served, older clients are refused")
+
+	fs.StringVar(&c.Storage.DBPath, "dbPath", c.Storage.DBPath, "database file for players, accounts and match history")
+	fs.StringVar(&c.Storage.UserFile, "userFile", c.Storage.UserFile, "json file to store accounts instead of the database")
+	fs.StringVar(&c.Storage.SnapshotFile, "snapshotFile", c.Storage.SnapshotFile, "file keeping players, rooms and tournaments across a restart, disabled if empty")
+
+	fs.StringVar(&c.Auth.TokenSecret, "tokenSecret", c.Auth.TokenSecret, "secret to sign tokens, read from tokenSecretFile if empty")
+	fs.StringVar(&c.Auth.TokenSecretFile, "tokenSecretFile", c.Auth.TokenSecretFile, "file keeping a generated token secret, a random secret per start is used if both are empty")
+	fs.IntVar(&c.Auth.TokenTTL, "tokenTTL", c.Auth.TokenTTL, "minutes before a token expires")
+	fs.StringVar(&c.Auth.AdminToken, "adminToken", c.Auth.AdminToken, "token of the admin service, the service is disabled if empty")
+
+	fs.StringVar(&c.Rating, "ratingSystem", c.Rating, "rating system(elo or tenhou)")
+
+	fs.IntVar(&c.Queue.Widen, "queueWiden", c.Queue.Widen, "seconds before the matchmaking rating band widens by one step")
+	fs.IntVar(&c.Queue.RobotAfter, "queueRobotAfter", c.Queue.RobotAfter, "seconds before robots fill a matchmaking table, 0 disables robots")
+	fs.StringVar(&c.Queue.RobotLevel, "queueRobotLevel", c.Queue.RobotLevel, "robot used to fill matchmaking tables")
+
+	fs.IntVar(&c.Shutdown.DrainTimeout, "drainTimeout", c.Shutdown.DrainTimeout, "seconds running games get to finish on shutdown")
+	fs.IntVar(&c.Shutdown.StopTimeout, "stopTimeout", c.Shutdown.StopTimeout, "seconds open streams get to close on shutdown before they are cut")
+	fs.StringVar(&c.Shutdown.MaintenanceNotice, "maintenanceNotice", c.Shutdown.MaintenanceNotice, "notice sent to every player on shutdown")
+}
+
+func (c *Server) Validate() error {
+	v := &validator{}
+	v.check(c.Listen.Port > 0 && c.Listen.Port < 65536, "listen.port %d must be between 1 and 65535", c.Listen.Port)
+	v.check(c.Keepalive.MinTime >= 0 && c.Keepalive.MaxConnectionIdle >= 0 && c.Keepalive.MaxConnectionAgeGrace >= 0 &&
+		c.Keepalive.Time >= 0 && c.Keepalive.Timeout >= 0, "keepalive values can't be negative")
+	c.TLS.validate(v)
+	c.Log.validate(v)
+	v.check(c.Limits.MaxClients > 0, "limits.maxClients %d must be at least 1", c.Limits.MaxClients)
+	v.check(c.Limits.RobotDecisionTimeout >= 0, "limits.robotDecisionTimeout %d can't be negative", c.Limits.RobotDecisionTimeout)
+	if c.Metrics.Address != "" {
+		_, _, err := net.SplitHostPort(c.Metrics.Address)
+		v.check(err == nil, "metrics.address %q: %v", c.Metrics.Address, err)
+	}
+	if c.Gateway.Address != "" {
+		_, _, err := net.SplitHostPort(c.Gateway.Address)
+		v.check(err == nil, "gateway.address %q: %v", c.Gateway.Address, err)
+	}
+	v.check(oneOf(c.Tracing.Exporter, "none", "stdout", "file"), "tracing.exporter %q must be none, stdout or file", c.Tracing.Exporter)
+	v.check(c.Tracing.Exporter != "file" || c.Tracing.File != "", "tracing.file is required by the file exporter")
+	v.check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sampleRatio %v must be between 0 and 1", c.Tracing.SampleRatio)
+	if min, err := protocol.Parse(c.Protocol.MinVersion); err != nil {
+		v.check(false, "protocol.minVersion: %v", err)
+	} else {
+		v.check(!min.Less(protocol.Matrix[0].Version) && !protocol.Current.Less(min),
+			"protocol.minVersion %s must be between %s and %s", min, protocol.Matrix[0].Version, protocol.Current)
+	}
+	v.check(c.Storage.DBPath != "", "storage.dbPath is required")
+	v.check(c.Auth.TokenTTL > 0, "auth.tokenTTL %d must be at least 1 minute", c.Auth.TokenTTL)
+	v.check(oneOf(c.Rating, "elo", "tenhou"), "rating %q must be elo or tenhou", c.Rating)
+	v.check(c.Queue.Widen > 0, "queue.widen %d must be at least 1 second", c.Queue.Widen)
+	v.check(c.Queue.RobotAfter >= 0, "queue.robotAfter %d can't be negative", c.Queue.RobotAfter)
+	v.check(c.Queue.RobotLevel != "", "queue.robotLevel is required")
+	v.check(c.Shutdown.DrainTimeout >= 0 && c.Shutdown.StopTimeout >= 0, "shutdown timeouts can't be negative")
+
+	names := make(map[string]bool)
+	for i, rs := range c.RuleSets {
+		v.check(rs.Name != "", "ruleSets[%d].name is required", i)
+		v.check(!names[rs.Name], "ruleSets[%d].name %q is used twice", i, rs.Name)
+		names[rs.Name] = true
+		if rs.SeatDraw != "" {
+			_, err := room.ParseSeatDraw(rs.SeatDraw)
+			v.check(err == nil, "ruleSets[%d] %q: %v", i, rs.Name, err)
+		}
+		v.check(len(rs.Uma) == 0 || len(rs.Uma) == 4, "ruleSets[%d] %q: uma needs 4 values, got %d", i, rs.Name, len(rs.Uma))
+		v.check(rs.StartPoints >= 0 && rs.ReturnPoints >= 0, "ruleSets[%d] %q: points can't be negative", i, rs.Name)
+	}
+	robotNames := make(map[string]bool)
+	for i, r := range c.Robots {
+		v.check(r.Name != "" && r.Use != "", "robots[%d] needs a name and the robot it uses", i)
+		v.check(!robotNames[r.Name], "robots[%d].name %q is used twice", i, r.Name)
+		robotNames[r.Name] = true
+	}
+	return v.err()
+}
+
+// Addr returns the address to listen on
+func (c *Server) Addr() string {
+	return fmt.Sprintf("%s:%d", c.Listen.Address, c.Listen.Port)
+}
+
+// MinProtocol returns the oldest client protocol version served, Validate has to pass first
+func (c *Server) MinProtocol() protocol.Version {
+	min, _ := protocol.Parse(c.Protocol.MinVersion)
+	return min
+}
+
+// RoomRuleSets converts the presets, Validate has to pass first
+func (c *Server) RoomRuleSets() []room.RuleSet {
+	ruleSets := make([]room.RuleSet, 0, len(c.RuleSets))
+	for _, rs := range c.RuleSets {
+		preset := room.RuleSet{Name: rs.Name, Scoring: room.DefaultScoring()}
+		if rs.SeatDraw != "" {
+			preset.SeatDraw, _ = room.ParseSeatDraw(rs.SeatDraw)
+		}
+		if rs.StartPoints > 0 {
+			preset.Scoring.StartPoints = rs.StartPoints
+		}
+		if rs.ReturnPoints > 0 {
+			preset.Scoring.ReturnPoints = rs.ReturnPoints
+		}
+		if len(rs.Uma) == 4 {
+			copy(preset.Scoring.Uma[:], rs.Uma)
+		}
+		ruleSets = append(ruleSets, preset)
+	}
+	return ruleSets
+}
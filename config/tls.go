@@ -0,0 +1,70 @@
+package config
+
+import (
+	"flag"
+	"os"
+)
+
+// ServerTLS serves gRPC over TLS when both files are set, plaintext otherwise.
+// Client certificates signed by ClientCAFile are verified, and required with RequireClientCert
+type ServerTLS struct {
+	CertFile          string `yaml:"certFile"`
+	KeyFile           string `yaml:"keyFile"`
+	ClientCAFile      string `yaml:"clientCAFile"`
+	RequireClientCert bool   `yaml:"requireClientCert"`
+}
+
+func (t *ServerTLS) bind(fs *flag.FlagSet) {
+	fs.StringVar(&t.CertFile, "tlsCert", t.CertFile, "PEM certificate of the server, TLS is disabled if empty")
+	fs.StringVar(&t.KeyFile, "tlsKey", t.KeyFile, "PEM private key of the server certificate")
+	fs.StringVar(&t.ClientCAFile, "tlsClientCA", t.ClientCAFile, "PEM certificate authority client certificates are verified with")
+	fs.BoolVar(&t.RequireClientCert, "tlsRequireClientCert", t.RequireClientCert, "reject clients without a certificate signed by tlsClientCA")
+}
+
+func (t *ServerTLS) validate(v *validator) {
+	v.check((t.CertFile == "") == (t.KeyFile == ""), "tls.certFile and tls.keyFile must be set together")
+	checkFile(v, "tls.certFile", t.CertFile)
+	checkFile(v, "tls.keyFile", t.KeyFile)
+	checkFile(v, "tls.clientCAFile", t.ClientCAFile)
+	v.check(t.CertFile != "" || t.ClientCAFile == "", "tls.clientCAFile needs tls.certFile")
+	v.check(t.ClientCAFile != "" || !t.RequireClientCert, "tls.requireClientCert needs tls.clientCAFile")
+}
+
+// Enabled reports whether the server serves TLS
+func (t *ServerTLS) Enabled() bool {
+	return t.CertFile != ""
+}
+
+// ClientTLS dials the server over TLS when Enabled is set, the certificate is sent if the server asks for it
+type ClientTLS struct {
+	Enabled    bool   `yaml:"enabled"`
+	CAFile     string `yaml:"caFile"`     // the system roots are used if empty
+	ServerName string `yaml:"serverName"` // the dialed address is used if empty
+	CertFile   string `yaml:"certFile"`
+	KeyFile    string `yaml:"keyFile"`
+}
+
+func (t *ClientTLS) bind(fs *flag.FlagSet) {
+	fs.BoolVar(&t.Enabled, "tls", t.Enabled, "dial the server over TLS")
+	fs.StringVar(&t.CAFile, "tlsCA", t.CAFile, "PEM certificate authority of the server, the system roots are used if empty")
+	fs.StringVar(&t.ServerName, "tlsServerName", t.ServerName, "name the server certificate is checked against, the address is used if empty")
+	fs.StringVar(&t.CertFile, "tlsCert", t.CertFile, "PEM client certificate for servers verifying clients")
+	fs.StringVar(&t.KeyFile, "tlsKey", t.KeyFile, "PEM private key of the client certificate")
+}
+
+func (t *ClientTLS) validate(v *validator) {
+	v.check(t.Enabled || (t.CAFile == "" && t.ServerName == "" && t.CertFile == ""), "tls settings need tls.enabled")
+	v.check((t.CertFile == "") == (t.KeyFile == ""), "tls.certFile and tls.keyFile must be set together")
+	checkFile(v, "tls.caFile", t.CAFile)
+	checkFile(v, "tls.certFile", t.CertFile)
+	checkFile(v, "tls.keyFile", t.KeyFile)
+}
+
+// checkFile reports a file setting that is set but can't be read
+func checkFile(v *validator, key string, path string) {
+	if path == "" {
+		return
+	}
+	_, err := os.Stat(path)
+	v.check(err == nil, "%s: %v", key, err)
+}
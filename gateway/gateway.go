@@ -0,0 +1,359 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/gorilla/websocket"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	"github.com/hphphp123321/mahjong-goserver/protocol"
+	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// maxBodySize limits the JSON body of a request
+const maxBodySize = 1 << 20
+
+// route maps an HTTP method and path to an RPC of the Mahjong service
+type route struct {
+	method string
+	path   string
+	rpc    string
+}
+
+// unaryRoutes are the RPCs the web frontend calls as JSON, GET requests take their fields from the query
+var unaryRoutes = []route{
+	{http.MethodPost, "/v1/hello", "Hello"},
+	{http.MethodPost, "/v1/login", "Login"},
+	{http.MethodGet, "/v1/rooms", "RefreshRoom"},
+	{http.MethodPost, "/v1/rooms", "CreateRoom"},
+	{http.MethodPost, "/v1/rooms/join", "JoinRoom"},
+}
+
+// streamRoutes are the streams bridged over WebSocket, every message is a JSON text frame,
+// the request of a server stream is the first frame sent by the browser
+var streamRoutes = []route{
+	{http.MethodGet, "/v1/ready", "Ready"},
+	{http.MethodGet, "/v1/start", "Start"},
+	{http.MethodGet, "/v1/lobby", "WatchLobby"},
+}
+
+type Options struct {
+	// Unary and Stream are the interceptors of the gRPC server, a call through the gateway goes through them as well
+	Unary  []grpc.UnaryServerInterceptor
+	Stream []grpc.StreamServerInterceptor
+	// AllowedOrigins may call the gateway from a browser, * allows every origin,
+	// only pages served from the gateway host are allowed if empty
+	AllowedOrigins []string
+}
+
+// Gateway serves the Mahjong service to browsers, unary RPCs as JSON over HTTP and streams over WebSocket.
+// The RPCs are dispatched to the same server the gRPC clients use, with the same authentication
+type Gateway struct {
+	srv      pb.MahjongServer
+	unary    grpc.UnaryServerInterceptor
+	stream   grpc.StreamServerInterceptor
+	origins  map[string]bool
+	upgrader websocket.Upgrader
+	mux      *http.ServeMux
+}
+
+func New(srv pb.MahjongServer, opts Options) *Gateway {
+	g := &Gateway{
+		srv:     srv,
+		unary:   chainUnary(opts.Unary),
+		stream:  chainStream(opts.Stream),
+		origins: make(map[string]bool),
+		mux:     http.NewServeMux(),
+	}
+	for _, origin := range opts.AllowedOrigins {
+		g.origins[origin] = true
+	}
+	g.upgrader.CheckOrigin = g.checkOrigin
+
+	handlers := make(map[string]map[string]http.HandlerFunc)
+	add := func(r route, h http.HandlerFunc) {
+		if handlers[r.path] == nil {
+			handlers[r.path] = make(map[string]http.HandlerFunc)
+		}
+		handlers[r.path][r.method] = h
+	}
+	for _, r := range unaryRoutes {
+		add(r, g.unaryHandler(findMethod(r.rpc)))
+	}
+	for _, r := range streamRoutes {
+		add(r, g.streamHandler(findStream(r.rpc)))
+	}
+	for path, methods := range handlers {
+		g.mux.Handle(path, g.cors(methods))
+	}
+	return g
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mux.ServeHTTP(w, r)
+}
+
+// findMethod panics on an RPC missing from the service, it is a programming error in the routes
+func findMethod(name string) grpc.MethodDesc {
+	for _, m := range pb.Mahjong_ServiceDesc.Methods {
+		if m.MethodName == name {
+			return m
+		}
+	}
+	panic(fmt.Sprintf("gateway: no method %s in %s", name, pb.Mahjong_ServiceDesc.ServiceName))
+}
+
+func findStream(name string) grpc.StreamDesc {
+	for _, s := range pb.Mahjong_ServiceDesc.Streams {
+		if s.StreamName == name {
+			return s
+		}
+	}
+	panic(fmt.Sprintf("gateway: no stream %s in %s", name, pb.Mahjong_ServiceDesc.ServiceName))
+}
+
+func fullMethod(name string) string {
+	return "/" + pb.Mahjong_ServiceDesc.ServiceName + "/" + name
+}
+
+func (g *Gateway) unaryHandler(desc grpc.MethodDesc) http.HandlerFunc {
+	method := fullMethod(desc.MethodName)
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := grpc.NewContextWithServerTransportStream(incomingContext(r, bearerToken(r)), &headerStream{method: method, header: w.Header()})
+		dec := func(m interface{}) error {
+			if err := decodeRequest(r, m.(proto.Message)); err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+			return nil
+		}
+		resp, err := desc.Handler(g.srv, ctx, dec, g.unary)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		data, err := marshalOptions.Marshal(resp.(proto.Message))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	}
+}
+
+// marshalOptions writes every field so the frontend doesn't have to know the proto defaults
+var marshalOptions = protojson.MarshalOptions{EmitUnpopulated: true}
+
+// decodeRequest reads the request from the JSON body, or from the query of a GET request,
+// query parameters only fill string fields
+func decodeRequest(r *http.Request, m proto.Message) error {
+	if r.Method == http.MethodGet {
+		fields := make(map[string]string)
+		for key, values := range r.URL.Query() {
+			fields[key] = values[0]
+		}
+		data, err := json.Marshal(fields)
+		if err != nil {
+			return err
+		}
+		return protojson.Unmarshal(data, m)
+	}
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	if err != nil {
+		return err
+	}
+	if len(data) > maxBodySize {
+		return fmt.Errorf("body is larger than %d bytes", maxBodySize)
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	return protojson.Unmarshal(data, m)
+}
+
+// incomingContext passes the token, the protocol version and the request and trace IDs on as gRPC metadata,
+// the interceptors read them from there, and the remote address as the peer. The protocol version may be
+// given by the protocol query parameter as well, for WebSockets
+func incomingContext(r *http.Request, token string) context.Context {
+	md := metadata.MD{}
+	if token != "" {
+		md.Set("token", token)
+	}
+	for _, key := range []string{protocol.Header, logging.RequestIDHeader, "traceparent", "tracestate"} {
+		if v := r.Header.Get(key); v != "" {
+			md.Set(key, v)
+		}
+	}
+	if v := r.URL.Query().Get("protocol"); v != "" && len(md.Get(protocol.Header)) == 0 {
+		md.Set(protocol.Header, v)
+	}
+	ctx := metadata.NewIncomingContext(r.Context(), md)
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+	}
+	return ctx
+}
+
+// bearerToken returns the token of the Authorization header
+func bearerToken(r *http.Request) string {
+	const prefix = "Bearer "
+	auth := r.Header.Get("Authorization")
+	if len(auth) > len(prefix) && strings.EqualFold(auth[:len(prefix)], prefix) {
+		return auth[len(prefix):]
+	}
+	return ""
+}
+
+// headerStream lets the interceptors set response headers like with a gRPC call,
+// the metadata becomes HTTP headers
+type headerStream struct {
+	method string
+	header http.Header
+}
+
+func (h *headerStream) Method() string {
+	return h.method
+}
+
+func (h *headerStream) SetHeader(md metadata.MD) error {
+	for key, values := range md {
+		for _, v := range values {
+			h.header.Add(key, v)
+		}
+	}
+	return nil
+}
+
+func (h *headerStream) SendHeader(md metadata.MD) error {
+	return h.SetHeader(md)
+}
+
+func (h *headerStream) SetTrailer(metadata.MD) error {
+	return nil
+}
+
+type errorBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(st.Code()))
+	_ = json.NewEncoder(w).Encode(errorBody{Code: st.Code().String(), Message: st.Message()})
+}
+
+// httpStatus maps the gRPC code the way grpc-gateway does
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+// checkOrigin allows pages of the gateway host and the allowed origins
+func (g *Gateway) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" || g.origins["*"] || g.origins[origin] {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, r.Host)
+}
+
+// cors dispatches on the HTTP method and answers the preflight requests of allowed origins
+func (g *Gateway) cors(methods map[string]http.HandlerFunc) http.Handler {
+	allow := make([]string, 0, len(methods))
+	for method := range methods {
+		allow = append(allow, method)
+	}
+	allowMethods := strings.Join(allow, ", ")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if origin := r.Header.Get("Origin"); origin != "" {
+			if !g.checkOrigin(r) {
+				writeError(w, status.Error(codes.PermissionDenied, "origin not allowed"))
+				return
+			}
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Expose-Headers", logging.RequestIDHeader)
+			w.Header().Add("Vary", "Origin")
+		}
+		if r.Method == http.MethodOptions {
+			w.Header().Set("Access-Control-Allow-Methods", allowMethods)
+			w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, "+protocol.Header+", "+logging.RequestIDHeader)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		h, ok := methods[r.Method]
+		if !ok {
+			w.Header().Set("Allow", allowMethods)
+			writeError(w, status.Errorf(codes.Unimplemented, "method %s not allowed", r.Method))
+			return
+		}
+		h(w, r)
+	})
+}
+
+// chainUnary runs the interceptors in order like grpc.ChainUnaryInterceptor
+func chainUnary(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, inner)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+// chainStream runs the interceptors in order like grpc.ChainStreamInterceptor
+func chainStream(interceptors []grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, inner)
+			}
+		}
+		return next(srv, ss)
+	}
+}
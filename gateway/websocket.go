@@ -0,0 +1,195 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// closeCodeBase is added to the gRPC code of the error ending a stream, 4000-4999 are left to applications
+const closeCodeBase = 4000
+
+// maxCloseReason is the room left for the reason in a close frame
+const maxCloseReason = 123
+
+// streamHandler bridges a stream over WebSocket, the token is read from the token query parameter
+// and the protocol version from the protocol one as browsers can't set headers on a WebSocket. The connection is upgraded on the first message,
+// a stream refused before, like without a valid token, gets a plain HTTP error
+func (g *Gateway) streamHandler(desc grpc.StreamDesc) http.HandlerFunc {
+	method := fullMethod(desc.StreamName)
+	info := &grpc.StreamServerInfo{
+		FullMethod:     method,
+		IsClientStream: desc.ClientStreams,
+		IsServerStream: desc.ServerStreams,
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := bearerToken(r)
+		if token == "" {
+			token = r.URL.Query().Get("token")
+		}
+		ctx, cancel := context.WithCancel(incomingContext(r, token))
+		defer cancel()
+		ws := &wsStream{
+			ctx:           ctx,
+			cancel:        cancel,
+			method:        method,
+			clientStreams: desc.ClientStreams,
+			w:             w,
+			r:             r,
+			upgrader:      &g.upgrader,
+			header:        make(http.Header),
+		}
+		ws.ctx = grpc.NewContextWithServerTransportStream(ctx, &headerStream{method: method, header: ws.header})
+		err := g.stream(g.srv, ws, info, desc.Handler)
+		ws.close(err)
+	}
+}
+
+// wsStream is a server stream over a WebSocket, messages are protojson text frames
+type wsStream struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	method   string
+	w        http.ResponseWriter
+	r        *http.Request
+	upgrader *websocket.Upgrader
+	header   http.Header
+	// clientStreams is false for a server stream, its only request is the first frame
+	clientStreams bool
+
+	once       sync.Once
+	conn       *websocket.Conn
+	upgradeErr error
+	writeMu    sync.Mutex
+	// decodeErr is the last message that could not be decoded, it is the close reason
+	// when the handler ends the stream on it without an error of its own
+	decodeErr error
+}
+
+// upgrade switches to WebSocket once, the headers set so far are sent with the handshake
+func (s *wsStream) upgrade() error {
+	s.once.Do(func() {
+		s.conn, s.upgradeErr = s.upgrader.Upgrade(s.w, s.r, s.header)
+		if s.upgradeErr != nil {
+			s.cancel()
+		}
+	})
+	return s.upgradeErr
+}
+
+func (s *wsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *wsStream) SetHeader(md metadata.MD) error {
+	for key, values := range md {
+		for _, v := range values {
+			s.header.Add(key, v)
+		}
+	}
+	return nil
+}
+
+func (s *wsStream) SendHeader(md metadata.MD) error {
+	if err := s.SetHeader(md); err != nil {
+		return err
+	}
+	return s.upgrade()
+}
+
+func (s *wsStream) SetTrailer(metadata.MD) {}
+
+func (s *wsStream) SendMsg(m interface{}) error {
+	if err := s.upgrade(); err != nil {
+		return err
+	}
+	data, err := marshalOptions.Marshal(m.(proto.Message))
+	if err != nil {
+		return err
+	}
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	return s.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+// RecvMsg returns io.EOF once the browser closed the WebSocket, the context is done after any error
+func (s *wsStream) RecvMsg(m interface{}) error {
+	if err := s.upgrade(); err != nil {
+		return err
+	}
+	_, data, err := s.conn.ReadMessage()
+	if err != nil {
+		s.cancel()
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+			return io.EOF
+		}
+		return err
+	}
+	if err = protojson.Unmarshal(data, m.(proto.Message)); err != nil {
+		err = status.Error(codes.InvalidArgument, err.Error())
+		s.writeMu.Lock()
+		s.decodeErr = err
+		s.writeMu.Unlock()
+		return err
+	}
+	if !s.clientStreams {
+		go s.discard()
+	}
+	return nil
+}
+
+// discard reads the frames after the request of a server stream, the handler doesn't receive
+// anymore but the context has to be done once the browser goes away
+func (s *wsStream) discard() {
+	for {
+		if _, _, err := s.conn.ReadMessage(); err != nil {
+			s.cancel()
+			return
+		}
+	}
+}
+
+// close ends the stream with the error as a close frame, or as an HTTP error when it was never upgraded
+func (s *wsStream) close(err error) {
+	s.once.Do(func() {
+		// the stream ended before its first message
+		if err == nil {
+			s.upgradeErr = errors.New("stream ended")
+			s.w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		s.upgradeErr = err
+		for key, values := range s.header {
+			s.w.Header()[key] = values
+		}
+		writeError(s.w, err)
+	})
+	if s.conn == nil {
+		return
+	}
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	if err == nil {
+		err = s.decodeErr
+	}
+	code, reason := websocket.CloseNormalClosure, ""
+	if err != nil {
+		st := status.Convert(err)
+		code, reason = closeCodeBase+int(st.Code()), st.Message()
+		if len(reason) > maxCloseReason {
+			reason = reason[:maxCloseReason]
+		}
+	}
+	_ = s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+	_ = s.conn.Close()
+}
@@ -0,0 +1,49 @@
+package logging
+
+import (
+	"context"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDHeader is read from the request metadata and sent back in the response header,
+// a request without one gets a new ID
+const RequestIDHeader = "x-request-id"
+
+func requestContext(ctx context.Context, method string) context.Context {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(RequestIDHeader); len(ids) > 0 && len(ids[0]) <= 64 {
+			requestID = ids[0]
+		}
+	}
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+	return WithFields(ctx, log.Fields{
+		RequestIDKey: requestID,
+		MethodKey:    method,
+	})
+}
+
+// UnaryServerInterceptor gives every RPC a request ID that the lines logged from its context carry
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(requestContext(ctx, info.FullMethod), req)
+}
+
+// StreamServerInterceptor gives every stream a request ID, lines of one stream share it
+func StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &loggedStream{ServerStream: ss, ctx: requestContext(ss.Context(), info.FullMethod)})
+}
+
+type loggedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *loggedStream) Context() context.Context {
+	return w.ctx
+}
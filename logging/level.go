@@ -0,0 +1,96 @@
+package logging
+
+import (
+	log "github.com/sirupsen/logrus"
+	"sync"
+)
+
+// levels lets a room log more than the rest of the server, the logger runs at the most verbose
+// level asked for and the lines above the level of their room are dropped by the formatter
+var levels = struct {
+	sync.RWMutex
+	global log.Level
+	rooms  map[string]log.Level
+}{global: log.InfoLevel, rooms: make(map[string]log.Level)}
+
+// Install wraps the formatter of the logger so room levels work, it is called once by the setup
+func Install(logger *log.Logger, level log.Level) {
+	logger.SetFormatter(&levelFormatter{Formatter: logger.Formatter})
+	SetLevel(level)
+}
+
+// Level returns the level of the lines not about a room with its own level
+func Level() log.Level {
+	levels.RLock()
+	defer levels.RUnlock()
+	return levels.global
+}
+
+// SetLevel changes the level at runtime and returns the previous one
+func SetLevel(level log.Level) log.Level {
+	levels.Lock()
+	defer levels.Unlock()
+	previous := levels.global
+	levels.global = level
+	applyLevel()
+	return previous
+}
+
+// SetRoomLevel changes the level of the lines of one room and returns the previous one
+func SetRoomLevel(roomID string, level log.Level) log.Level {
+	levels.Lock()
+	defer levels.Unlock()
+	previous, ok := levels.rooms[roomID]
+	if !ok {
+		previous = levels.global
+	}
+	levels.rooms[roomID] = level
+	applyLevel()
+	return previous
+}
+
+// ClearRoomLevel puts the room back to the server level, it is called once the room is removed
+func ClearRoomLevel(roomID string) {
+	levels.Lock()
+	defer levels.Unlock()
+	if _, ok := levels.rooms[roomID]; ok {
+		delete(levels.rooms, roomID)
+		applyLevel()
+	}
+}
+
+// applyLevel sets the logger to the most verbose level in use, levels has to be locked
+func applyLevel() {
+	level := levels.global
+	for _, l := range levels.rooms {
+		if l > level {
+			level = l
+		}
+	}
+	log.SetLevel(level)
+	log.SetReportCaller(level == log.DebugLevel || level == log.TraceLevel)
+}
+
+// enabled reports whether the entry is at or above the level of its room
+func enabled(entry *log.Entry) bool {
+	levels.RLock()
+	defer levels.RUnlock()
+	level := levels.global
+	if roomID, ok := entry.Data[RoomIDKey].(string); ok {
+		if l, ok := levels.rooms[roomID]; ok {
+			level = l
+		}
+	}
+	return entry.Level <= level
+}
+
+type levelFormatter struct {
+	log.Formatter
+}
+
+func (f *levelFormatter) Format(entry *log.Entry) ([]byte, error) {
+	if !enabled(entry) {
+		return nil, nil
+	}
+	return f.Formatter.Format(entry)
+}
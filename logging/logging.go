@@ -0,0 +1,59 @@
+package logging
+
+import (
+	"context"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+// field keys of the server log lines, lines about a room carry RoomIDKey so they can be followed per room
+const (
+	EventKey      = "Event"
+	RequestIDKey  = "RequestID"
+	MethodKey     = "Method"
+	PlayerNameKey = "PlayerName"
+	SessionKey    = "Session"
+	SeatKey       = "Seat"
+	RoomIDKey     = "RoomID"
+	RoomNameKey   = "RoomName"
+	MatchIDKey    = "MatchID"
+	HandIDKey     = "HandID"
+	HandsKey      = "Hands"
+)
+
+type entryKey struct{}
+
+// NewContext returns a context carrying the entry, FromContext returns it to add the fields to every line
+func NewContext(ctx context.Context, entry *log.Entry) context.Context {
+	return context.WithValue(ctx, entryKey{}, entry)
+}
+
+// FromContext returns the entry of the request, or a bare entry of the standard logger
+func FromContext(ctx context.Context) *log.Entry {
+	if entry, ok := ctx.Value(entryKey{}).(*log.Entry); ok {
+		return entry
+	}
+	return log.NewEntry(log.StandardLogger())
+}
+
+// WithFields adds the fields to the entry of the context
+func WithFields(ctx context.Context, fields log.Fields) context.Context {
+	return NewContext(ctx, FromContext(ctx).WithFields(fields))
+}
+
+// Room adds the fields of a room to the entry, matchID is left out while no match is running
+func Room(entry *log.Entry, roomID uuid.UUID, roomName string, matchID uuid.UUID) *log.Entry {
+	fields := log.Fields{
+		RoomIDKey:   roomID.String(),
+		RoomNameKey: roomName,
+	}
+	if matchID != uuid.Nil {
+		fields[MatchIDKey] = matchID.String()
+	}
+	return entry.WithFields(fields)
+}
+
+// Hand adds the hand of the running match to the entry, hands are numbered from 1
+func Hand(entry *log.Entry, hand int) *log.Entry {
+	return entry.WithField(HandIDKey, hand)
+}
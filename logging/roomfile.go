@@ -0,0 +1,143 @@
+package logging
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// maxRoomFiles is how many room files stay open, the least recently written is closed first
+const maxRoomFiles = 128
+
+// RoomFileHook appends the lines about a room to <dir>/<room id>.log as well
+type RoomFileHook struct {
+	dir       string
+	formatter log.Formatter
+	mu        sync.Mutex
+	files     map[string]*roomFile
+}
+
+type roomFile struct {
+	f        *os.File
+	lastUsed time.Time
+}
+
+func NewRoomFileHook(dir string) (*RoomFileHook, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &RoomFileHook{
+		dir: dir,
+		formatter: &log.TextFormatter{
+			DisableColors:   true,
+			FullTimestamp:   true,
+			TimestampFormat: "2006-01-02 15:04:05.000",
+		},
+		files: make(map[string]*roomFile),
+	}, nil
+}
+
+func (h *RoomFileHook) Levels() []log.Level {
+	return log.AllLevels
+}
+
+func (h *RoomFileHook) Fire(entry *log.Entry) error {
+	roomID, ok := entry.Data[RoomIDKey].(string)
+	if !ok || !enabled(entry) {
+		return nil
+	}
+	// the id names the file, it must not walk out of the directory
+	if _, err := uuid.Parse(roomID); err != nil {
+		return nil
+	}
+	line, err := h.formatter.Format(entry)
+	if err != nil {
+		return err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	rf, err := h.open(roomID)
+	if err != nil {
+		return err
+	}
+	rf.lastUsed = time.Now()
+	_, err = rf.f.Write(line)
+	return err
+}
+
+func (h *RoomFileHook) open(roomID string) (*roomFile, error) {
+	if rf, ok := h.files[roomID]; ok {
+		return rf, nil
+	}
+	if len(h.files) >= maxRoomFiles {
+		var oldest string
+		for id, rf := range h.files {
+			if oldest == "" || rf.lastUsed.Before(h.files[oldest].lastUsed) {
+				oldest = id
+			}
+		}
+		h.closeFile(oldest)
+	}
+	f, err := os.OpenFile(filepath.Join(h.dir, roomID+".log"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("open room log: %w", err)
+	}
+	rf := &roomFile{f: f}
+	h.files[roomID] = rf
+	return rf, nil
+}
+
+// Release closes the file of a removed room, later lines about it open the file again
+func (h *RoomFileHook) Release(roomID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closeFile(roomID)
+}
+
+func (h *RoomFileHook) closeFile(roomID string) {
+	if rf, ok := h.files[roomID]; ok {
+		_ = rf.f.Close()
+		delete(h.files, roomID)
+	}
+}
+
+var splitHook struct {
+	sync.Mutex
+	hook *RoomFileHook
+}
+
+// SplitRooms writes the lines about a room to a file per room in dir as well
+func SplitRooms(dir string) error {
+	hook, err := NewRoomFileHook(dir)
+	if err != nil {
+		return err
+	}
+	splitHook.Lock()
+	splitHook.hook = hook
+	splitHook.Unlock()
+	log.AddHook(hook)
+	return nil
+}
+
+// ReleaseRoom forgets the level of a removed room and closes its file
+func ReleaseRoom(roomID uuid.UUID) {
+	ClearRoomLevel(roomID.String())
+	splitHook.Lock()
+	defer splitHook.Unlock()
+	if splitHook.hook != nil {
+		splitHook.hook.Release(roomID.String())
+	}
+}
+
+// Close closes every room file
+func (h *RoomFileHook) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for id := range h.files {
+		h.closeFile(id)
+	}
+}
@@ -0,0 +1,267 @@
+package matchmaking
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	ErrAlreadyQueued = errors.New("already in queue")
+	ErrNotQueued     = errors.New("not in queue")
+)
+
+// Options controls how fast the rating band widens and when robots fill a table
+type Options struct {
+	// WidenEvery adds one rating step to the band of a ticket each time it elapses
+	WidenEvery time.Duration
+	// RobotAfter is how long a ticket waits before robots may fill its table, 0 disables robots
+	RobotAfter time.Duration
+	// RobotLevel is the robot registered in the robots package used to fill tables
+	RobotLevel string
+}
+
+func DefaultOptions() Options {
+	return Options{
+		WidenEvery: 10 * time.Second,
+		RobotAfter: 60 * time.Second,
+		RobotLevel: "Simple",
+	}
+}
+
+// Ticket is a player waiting in the queue
+type Ticket struct {
+	Token       uuid.UUID
+	PlayerName  string
+	RuleSet     string
+	Value       float64 // rating.System Value of the player
+	AllowRobots bool
+	JoinedAt    time.Time
+
+	// Matched receives the room the ticket was seated in
+	Matched chan uuid.UUID
+	// Left is closed when the ticket leaves the queue without a match
+	Left chan struct{}
+	// err is why the ticket left if the player didn't ask to, set before Left is closed
+	err error
+}
+
+func NewTicket(token uuid.UUID, playerName string, ruleSet string, value float64, allowRobots bool) *Ticket {
+	return &Ticket{
+		Token:       token,
+		PlayerName:  playerName,
+		RuleSet:     ruleSet,
+		Value:       value,
+		AllowRobots: allowRobots,
+		JoinedAt:    time.Now(),
+		Matched:     make(chan uuid.UUID, 1),
+		Left:        make(chan struct{}),
+	}
+}
+
+// Fail ends a ticket taken out of the queue by Match whose table could not be seated,
+// Left is closed and Err returns err
+func (t *Ticket) Fail(err error) {
+	t.err = err
+	close(t.Left)
+}
+
+// Err returns why the ticket failed once Left is closed, nil if the player left the queue
+func (t *Ticket) Err() error {
+	return t.err
+}
+
+// Group is a table found by the queue, Robots seats are left for robots
+type Group struct {
+	RuleSet string
+	Tickets []*Ticket
+	Robots  int
+}
+
+// Queue groups waiting players by rule set and rating band
+type Queue struct {
+	opts    Options
+	step    float64
+	tickets []*Ticket
+
+	mu sync.Mutex
+}
+
+func NewQueue(step float64, opts Options) *Queue {
+	return &Queue{
+		opts: opts,
+		step: step,
+	}
+}
+
+func (q *Queue) Options() Options {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.opts
+}
+
+func (q *Queue) SetOptions(opts Options) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.opts = opts
+}
+
+func (q *Queue) Join(t *Ticket) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.indexOf(t.Token) >= 0 {
+		return ErrAlreadyQueued
+	}
+	q.tickets = append(q.tickets, t)
+	return nil
+}
+
+// Leave removes the ticket of the player and closes its Left channel
+func (q *Queue) Leave(token uuid.UUID) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	i := q.indexOf(token)
+	if i < 0 {
+		return ErrNotQueued
+	}
+	close(q.tickets[i].Left)
+	q.tickets = append(q.tickets[:i], q.tickets[i+1:]...)
+	return nil
+}
+
+// Clear removes every ticket and closes their Left channels
+func (q *Queue) Clear() []*Ticket {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	tickets := q.tickets
+	for _, t := range tickets {
+		close(t.Left)
+	}
+	q.tickets = nil
+	return tickets
+}
+
+func (q *Queue) Contains(token uuid.UUID) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.indexOf(token) >= 0
+}
+
+// Len returns the number of tickets waiting for the rule set
+func (q *Queue) Len(ruleSet string) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	n := 0
+	for _, t := range q.tickets {
+		if t.RuleSet == ruleSet {
+			n++
+		}
+	}
+	return n
+}
+
+// Counts returns the number of tickets waiting per rule set
+func (q *Queue) Counts() map[string]int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	counts := make(map[string]int)
+	for _, t := range q.tickets {
+		counts[t.RuleSet]++
+	}
+	return counts
+}
+
+// Band is the rating distance a ticket accepts at now
+func (q *Queue) Band(t *Ticket, now time.Time) float64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.band(t, now)
+}
+
+// Match removes and returns every table that can be formed at now,
+// the oldest tickets are served first
+func (q *Queue) Match(now time.Time) []*Group {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	sort.SliceStable(q.tickets, func(i, j int) bool {
+		return q.tickets[i].JoinedAt.Before(q.tickets[j].JoinedAt)
+	})
+	groups := make([]*Group, 0)
+	matched := make(map[uuid.UUID]bool)
+	for _, t := range q.tickets {
+		if matched[t.Token] {
+			continue
+		}
+		candidates := q.candidates(t, now, matched)
+		var g *Group
+		if len(candidates) >= 3 {
+			g = &Group{RuleSet: t.RuleSet, Tickets: append([]*Ticket{t}, candidates[:3]...)}
+		} else if q.robotsAllowed(t, now) {
+			g = &Group{RuleSet: t.RuleSet, Tickets: []*Ticket{t}}
+			for _, c := range candidates {
+				if c.AllowRobots {
+					g.Tickets = append(g.Tickets, c)
+				}
+			}
+			g.Robots = 4 - len(g.Tickets)
+		}
+		if g == nil {
+			continue
+		}
+		for _, m := range g.Tickets {
+			matched[m.Token] = true
+		}
+		groups = append(groups, g)
+	}
+	remaining := q.tickets[:0]
+	for _, t := range q.tickets {
+		if !matched[t.Token] {
+			remaining = append(remaining, t)
+		}
+	}
+	q.tickets = remaining
+	return groups
+}
+
+// candidates returns the unmatched tickets of the same rule set whose band and the band
+// of t cover each other, closest rating first
+func (q *Queue) candidates(t *Ticket, now time.Time, matched map[uuid.UUID]bool) []*Ticket {
+	candidates := make([]*Ticket, 0)
+	for _, c := range q.tickets {
+		if c == t || matched[c.Token] || c.RuleSet != t.RuleSet {
+			continue
+		}
+		diff := math.Abs(c.Value - t.Value)
+		if diff <= q.band(t, now) && diff <= q.band(c, now) {
+			candidates = append(candidates, c)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return math.Abs(candidates[i].Value-t.Value) < math.Abs(candidates[j].Value-t.Value)
+	})
+	return candidates
+}
+
+func (q *Queue) robotsAllowed(t *Ticket, now time.Time) bool {
+	return t.AllowRobots && q.opts.RobotAfter > 0 && now.Sub(t.JoinedAt) >= q.opts.RobotAfter
+}
+
+func (q *Queue) band(t *Ticket, now time.Time) float64 {
+	steps := 1.0
+	if q.opts.WidenEvery > 0 {
+		steps += math.Floor(float64(now.Sub(t.JoinedAt)) / float64(q.opts.WidenEvery))
+	}
+	return steps * q.step
+}
+
+func (q *Queue) indexOf(token uuid.UUID) int {
+	for i, t := range q.tickets {
+		if t.Token == token {
+			return i
+		}
+	}
+	return -1
+}
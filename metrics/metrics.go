@@ -0,0 +1,291 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Default holds the metrics of packages without a server, like the robots
+var Default = NewRegistry()
+
+// DefBuckets are the upper bounds in seconds used for latencies
+var DefBuckets = []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+type collector interface {
+	describe() (name string, help string, kind string)
+	write(w io.Writer)
+}
+
+// Registry keeps metrics and writes them in the Prometheus text format
+type Registry struct {
+	mu         sync.RWMutex
+	collectors []collector
+	names      map[string]bool
+}
+
+func NewRegistry() *Registry {
+	return &Registry{names: make(map[string]bool)}
+}
+
+// register panics on a name used twice, it is a programming error like a duplicate flag
+func (r *Registry) register(c collector) {
+	name, _, _ := c.describe()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.names[name] {
+		panic(fmt.Sprintf("metrics: %s registered twice", name))
+	}
+	r.names[name] = true
+	r.collectors = append(r.collectors, c)
+}
+
+// Write writes every metric sorted by name
+func (r *Registry) Write(w io.Writer) {
+	r.mu.RLock()
+	collectors := make([]collector, len(r.collectors))
+	copy(collectors, r.collectors)
+	r.mu.RUnlock()
+	sort.Slice(collectors, func(i, j int) bool {
+		a, _, _ := collectors[i].describe()
+		b, _, _ := collectors[j].describe()
+		return a < b
+	})
+	for _, c := range collectors {
+		name, help, kind := c.describe()
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+		c.write(w)
+	}
+}
+
+// Handler serves the metrics of the registries on GET
+func Handler(registries ...*Registry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		bw := bufio.NewWriter(w)
+		for _, r := range registries {
+			r.Write(bw)
+		}
+		_ = bw.Flush()
+	})
+}
+
+type desc struct {
+	name   string
+	help   string
+	labels []string
+}
+
+// key joins label values, the separator can't appear in valid UTF-8 label values
+func (d *desc) key(values []string) string {
+	if len(values) != len(d.labels) {
+		panic(fmt.Sprintf("metrics: %s wants %d label values, got %d", d.name, len(d.labels), len(values)))
+	}
+	return strings.Join(values, "\xff")
+}
+
+// labelPairs formats the labels of a series, extra is appended as is
+func (d *desc) labelPairs(key string, extra string) string {
+	var pairs []string
+	if len(d.labels) > 0 {
+		values := strings.Split(key, "\xff")
+		for i, l := range d.labels {
+			// %q escapes quotes, backslashes and new lines the way the exposition format wants
+			pairs = append(pairs, fmt.Sprintf("%s=%q", l, values[i]))
+		}
+	}
+	if extra != "" {
+		pairs = append(pairs, extra)
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// series is a value per label combination
+type series struct {
+	desc
+	kind   string
+	mu     sync.Mutex
+	values map[string]float64
+}
+
+func (s *series) describe() (string, string, string) {
+	return s.name, s.help, s.kind
+}
+
+func (s *series) add(v float64, labelValues []string) {
+	key := s.key(labelValues)
+	s.mu.Lock()
+	s.values[key] += v
+	s.mu.Unlock()
+}
+
+func (s *series) write(w io.Writer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]string, 0, len(s.values))
+	for k := range s.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(w, "%s%s %s\n", s.name, s.labelPairs(k, ""), formatValue(s.values[k]))
+	}
+}
+
+// Counter only goes up
+type Counter struct {
+	s *series
+}
+
+func (r *Registry) NewCounter(name string, help string, labels ...string) *Counter {
+	c := &Counter{s: &series{desc: desc{name, help, labels}, kind: "counter", values: make(map[string]float64)}}
+	r.register(c.s)
+	return c
+}
+
+func (c *Counter) Inc(labelValues ...string) {
+	c.s.add(1, labelValues)
+}
+
+func (c *Counter) Add(v float64, labelValues ...string) {
+	if v < 0 {
+		panic(fmt.Sprintf("metrics: counter %s can't go down", c.s.name))
+	}
+	c.s.add(v, labelValues)
+}
+
+// Gauge goes up and down
+type Gauge struct {
+	s *series
+}
+
+func (r *Registry) NewGauge(name string, help string, labels ...string) *Gauge {
+	g := &Gauge{s: &series{desc: desc{name, help, labels}, kind: "gauge", values: make(map[string]float64)}}
+	r.register(g.s)
+	return g
+}
+
+func (g *Gauge) Set(v float64, labelValues ...string) {
+	key := g.s.key(labelValues)
+	g.s.mu.Lock()
+	g.s.values[key] = v
+	g.s.mu.Unlock()
+}
+
+func (g *Gauge) Add(v float64, labelValues ...string) {
+	g.s.add(v, labelValues)
+}
+
+// gaugeFunc is a gauge computed on every scrape
+type gaugeFunc struct {
+	desc
+	collect func(emit func(v float64, labelValues ...string))
+}
+
+// NewGaugeFunc registers a gauge whose series are emitted by collect when the metrics are written,
+// for values the server already keeps like the number of clients
+func (r *Registry) NewGaugeFunc(name string, help string, labels []string, collect func(emit func(v float64, labelValues ...string))) {
+	r.register(&gaugeFunc{desc: desc{name, help, labels}, collect: collect})
+}
+
+func (g *gaugeFunc) describe() (string, string, string) {
+	return g.name, g.help, "gauge"
+}
+
+func (g *gaugeFunc) write(w io.Writer) {
+	values := make(map[string]float64)
+	g.collect(func(v float64, labelValues ...string) {
+		values[g.key(labelValues)] += v
+	})
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(w, "%s%s %s\n", g.name, g.labelPairs(k, ""), formatValue(values[k]))
+	}
+}
+
+// Histogram counts observations in cumulative buckets
+type Histogram struct {
+	desc
+	buckets []float64
+	mu      sync.Mutex
+	series  map[string]*histogramSeries
+}
+
+type histogramSeries struct {
+	counts []uint64 // per bucket, not cumulative
+	count  uint64
+	sum    float64
+}
+
+func (r *Registry) NewHistogram(name string, help string, buckets []float64, labels ...string) *Histogram {
+	b := make([]float64, len(buckets))
+	copy(b, buckets)
+	sort.Float64s(b)
+	h := &Histogram{desc: desc{name, help, labels}, buckets: b, series: make(map[string]*histogramSeries)}
+	r.register(h)
+	return h
+}
+
+func (h *Histogram) Observe(v float64, labelValues ...string) {
+	key := h.key(labelValues)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s, ok := h.series[key]
+	if !ok {
+		s = &histogramSeries{counts: make([]uint64, len(h.buckets))}
+		h.series[key] = s
+	}
+	if i := sort.SearchFloat64s(h.buckets, v); i < len(h.buckets) {
+		s.counts[i]++
+	}
+	s.count++
+	s.sum += v
+}
+
+func (h *Histogram) describe() (string, string, string) {
+	return h.name, h.help, "histogram"
+}
+
+func (h *Histogram) write(w io.Writer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	keys := make([]string, 0, len(h.series))
+	for k := range h.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		s := h.series[k]
+		var cumulative uint64
+		for i, upper := range h.buckets {
+			cumulative += s.counts[i]
+			fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, h.labelPairs(k, fmt.Sprintf("le=%q", formatValue(upper))), cumulative)
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, h.labelPairs(k, `le="+Inf"`), s.count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", h.name, h.labelPairs(k, ""), formatValue(s.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", h.name, h.labelPairs(k, ""), s.count)
+	}
+}
@@ -21,7 +21,7 @@ func WaitExit(c chan os.Signal, exit func()) {
 
 // NewShutdownSignal new normal Signal channel
 func NewShutdownSignal() chan os.Signal {
-	c := make(chan os.Signal)
+	c := make(chan os.Signal, 1)
 	// SIGHUP: terminal closed
 	// SIGINT: Ctrl+C
 	// SIGTERM: program exit
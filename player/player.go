@@ -9,6 +9,7 @@ type Player struct {
 	RoomID uuid.UUID `json:"room_id"`
 	Seat   int       `json:"seat"`
 	Ready  bool      `json:"ready"`
+	Rank   string    `json:"rank"`
 
 	Agent GameAgent `json:"-"`
 }
@@ -21,14 +22,20 @@ func NewPlayer(playerName string, playerID uuid.UUID) *Player {
 	}
 }
 
+// NewRobot returns a robot player, robots are always ready
 func NewRobot(robotName string, seat int, agent GameAgent) *Player {
 	return &Player{
 		PlayerName: robotName,
 		Seat:       seat,
+		Ready:      true,
 		Agent:      agent,
 	}
 }
 
+func (p *Player) IsRobot() bool {
+	return p.Agent != nil
+}
+
 func (p *Player) SetReady(ready bool) {
 	p.Ready = ready
 }
@@ -0,0 +1,47 @@
+package player
+
+// HandStats counts what a player did over a number of hands
+type HandStats struct {
+	Hands     int `json:"hands"`
+	Wins      int `json:"wins"`
+	DealIns   int `json:"deal_ins"`
+	Riichis   int `json:"riichis"`
+	Calls     int `json:"calls"` // hands with at least one open meld
+	WinPoints int `json:"win_points"`
+}
+
+func (h *HandStats) Add(o HandStats) {
+	h.Hands += o.Hands
+	h.Wins += o.Wins
+	h.DealIns += o.DealIns
+	h.Riichis += o.Riichis
+	h.Calls += o.Calls
+	h.WinPoints += o.WinPoints
+}
+
+func (h *HandStats) WinRate() float64 {
+	return rate(h.Wins, h.Hands)
+}
+
+func (h *HandStats) DealInRate() float64 {
+	return rate(h.DealIns, h.Hands)
+}
+
+func (h *HandStats) RiichiRate() float64 {
+	return rate(h.Riichis, h.Hands)
+}
+
+func (h *HandStats) CallRate() float64 {
+	return rate(h.Calls, h.Hands)
+}
+
+func (h *HandStats) AverageWinPoints() float64 {
+	return rate(h.WinPoints, h.Wins)
+}
+
+func rate(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total)
+}
@@ -0,0 +1,170 @@
+package protocol
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Header carries the protocol version of the client in the metadata of every call
+const Header = "x-mahjong-protocol"
+
+var (
+	ErrBadVersion   = errors.New("protocol version must look like 1.1")
+	ErrIncompatible = errors.New("incompatible protocol version")
+)
+
+// Version of mahjong.proto, the major version follows the services/mahjong/v1 path and
+// the minor version goes up when messages, fields or RPCs are added
+type Version struct {
+	Major int
+	Minor int
+}
+
+var (
+	// Current is the protocol of this build, bump it with every change of mahjong.proto
+	Current = Version{1, 2}
+	// Legacy is assumed for a client that doesn't send its version, it was built before the handshake
+	Legacy = Version{1, 0}
+)
+
+func Parse(s string) (Version, error) {
+	major, minor, ok := strings.Cut(strings.TrimPrefix(s, "v"), ".")
+	if !ok {
+		return Version{}, fmt.Errorf("%w, got %q", ErrBadVersion, s)
+	}
+	var v Version
+	var err1, err2 error
+	v.Major, err1 = strconv.Atoi(major)
+	v.Minor, err2 = strconv.Atoi(minor)
+	if err1 != nil || err2 != nil || v.Major < 0 || v.Minor < 0 {
+		return Version{}, fmt.Errorf("%w, got %q", ErrBadVersion, s)
+	}
+	return v, nil
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+func (v Version) Less(o Version) bool {
+	if v.Major != o.Major {
+		return v.Major < o.Major
+	}
+	return v.Minor < o.Minor
+}
+
+// Support is how the server treats clients of a protocol version
+type Support int
+
+const (
+	Supported   Support = iota
+	Deprecated          // still served, the client should be updated
+	Unsupported         // refused
+)
+
+var supportNames = map[Support]string{
+	Supported:   "supported",
+	Deprecated:  "deprecated",
+	Unsupported: "unsupported",
+}
+
+func (s Support) String() string {
+	if name, ok := supportNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("Support(%d)", int(s))
+}
+
+// Release is a protocol version in the compatibility matrix
+type Release struct {
+	Version Version
+	Support Support
+	Note    string
+}
+
+// Matrix lists the protocol versions this server knows, oldest first. A version older than
+// the first one or of another major version is unsupported
+var Matrix = []Release{
+	{Version{1, 0}, Deprecated, "clients built before the handshake, they don't get lobby updates"},
+	{Version{1, 1}, Supported, "adds Hello and WatchLobby"},
+	{Version{1, 2}, Supported, "adds the dice and the draw order of the wind seat draw"},
+}
+
+// features a client may ask for in the handshake
+const (
+	FeatureReconnect = "reconnect"
+	FeatureSanma     = "sanma"
+	FeatureSpectate  = "spectate"
+)
+
+// ServerFeatures are the features this server has, three player games and spectating are not implemented
+var ServerFeatures = []string{FeatureReconnect}
+
+// MatrixFrom is the matrix with the versions older than min as unsupported
+func MatrixFrom(min Version) []Release {
+	releases := make([]Release, len(Matrix))
+	copy(releases, Matrix)
+	for i := range releases {
+		if releases[i].Version.Less(min) {
+			releases[i].Support = Unsupported
+		}
+	}
+	return releases
+}
+
+// Check returns how a client of the version is served, or an error telling what to do about it.
+// min is the oldest version the server accepts, notice explains a deprecated or newer version
+func Check(client Version, min Version) (support Support, notice string, err error) {
+	if client.Major != Current.Major {
+		return Unsupported, "", fmt.Errorf("%w: the client speaks protocol %s but the server speaks %s, use a client built for protocol %d.x",
+			ErrIncompatible, client, Current, Current.Major)
+	}
+	if client.Less(min) {
+		return Unsupported, "", fmt.Errorf("%w: protocol %s is no longer accepted, the server accepts %s to %s, update the client",
+			ErrIncompatible, client, min, Current)
+	}
+	if Current.Less(client) {
+		return Supported, fmt.Sprintf("the client speaks protocol %s, newer than the server (%s), features added after %s are not available",
+			client, Current, Current), nil
+	}
+	for _, r := range MatrixFrom(min) {
+		if r.Version != client {
+			continue
+		}
+		switch r.Support {
+		case Unsupported:
+			return Unsupported, "", fmt.Errorf("%w: protocol %s is not supported (%s), the server accepts %s to %s, update the client",
+				ErrIncompatible, client, r.Note, min, Current)
+		case Deprecated:
+			return Deprecated, fmt.Sprintf("protocol %s is deprecated: %s", client, r.Note), nil
+		}
+		return Supported, "", nil
+	}
+	// minor versions without an entry changed nothing this server cares about
+	return Supported, "", nil
+}
+
+// Negotiate splits the features asked for by a client into the ones it may use and the ones the server lacks,
+// names the server doesn't know are left out of both
+func Negotiate(asked []string) (enabled []string, missing []string) {
+	known := map[string]bool{FeatureReconnect: true, FeatureSanma: true, FeatureSpectate: true}
+	have := make(map[string]bool, len(ServerFeatures))
+	for _, f := range ServerFeatures {
+		have[f] = true
+	}
+	seen := make(map[string]bool, len(asked))
+	for _, f := range asked {
+		if seen[f] || !known[f] {
+			continue
+		}
+		seen[f] = true
+		if have[f] {
+			enabled = append(enabled, f)
+		} else {
+			missing = append(missing, f)
+		}
+	}
+	return enabled, missing
+}
@@ -0,0 +1,102 @@
+package protocol
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Version
+		wantErr bool
+	}{
+		{"1.2", Version{1, 2}, false},
+		{"v1.0", Version{1, 0}, false},
+		{"10.15", Version{10, 15}, false},
+		{"1", Version{}, true},
+		{"1.x", Version{}, true},
+		{"-1.0", Version{}, true},
+		{"", Version{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Parse(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, ErrBadVersion) {
+					t.Fatalf("Parse(%q) error = %v, want ErrBadVersion", tt.in, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Fatalf("Parse(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	next := Version{Current.Major, Current.Minor + 1}
+	tests := []struct {
+		name       string
+		client     Version
+		min        Version
+		want       Support
+		wantNotice bool
+		wantErr    bool
+	}{
+		{"current", Current, Legacy, Supported, false, false},
+		{"major mismatch", Version{Current.Major + 1, 0}, Legacy, Unsupported, false, true},
+		{"older major", Version{Current.Major - 1, 9}, Legacy, Unsupported, false, true},
+		{"newer minor", next, Legacy, Supported, true, false},
+		{"deprecated entry", Version{1, 0}, Legacy, Deprecated, true, false},
+		{"below min", Version{1, 0}, Version{1, 1}, Unsupported, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			support, notice, err := Check(tt.client, tt.min)
+			if support != tt.want {
+				t.Errorf("support = %v, want %v", support, tt.want)
+			}
+			if (notice != "") != tt.wantNotice {
+				t.Errorf("notice = %q, want a notice: %v", notice, tt.wantNotice)
+			}
+			if tt.wantErr != errors.Is(err, ErrIncompatible) {
+				t.Errorf("err = %v, want ErrIncompatible: %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMatrixFrom(t *testing.T) {
+	releases := MatrixFrom(Version{1, 1})
+	if releases[0].Support != Unsupported {
+		t.Errorf("1.0 is %v below the min, want unsupported", releases[0].Support)
+	}
+	if Matrix[0].Support != Deprecated {
+		t.Error("MatrixFrom changed the matrix")
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name        string
+		asked       []string
+		wantEnabled []string
+		wantMissing []string
+	}{
+		{"none", nil, nil, nil},
+		{"served", []string{FeatureReconnect}, []string{FeatureReconnect}, nil},
+		{"not implemented", []string{FeatureSanma, FeatureSpectate}, nil, []string{FeatureSanma, FeatureSpectate}},
+		{"unknown and repeated", []string{"teleport", FeatureReconnect, FeatureReconnect, FeatureSanma}, []string{FeatureReconnect}, []string{FeatureSanma}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enabled, missing := Negotiate(tt.asked)
+			if !reflect.DeepEqual(enabled, tt.wantEnabled) || !reflect.DeepEqual(missing, tt.wantMissing) {
+				t.Errorf("Negotiate(%v) = %v, %v, want %v, %v", tt.asked, enabled, missing, tt.wantEnabled, tt.wantMissing)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package rating
+
+import (
+	"fmt"
+	"math"
+)
+
+// Elo treats a match as every pair of players playing each other
+type Elo struct {
+	K     float64
+	Start float64
+}
+
+func NewElo() *Elo {
+	return &Elo{
+		K:     16,
+		Start: 1500,
+	}
+}
+
+func (e *Elo) Name() string {
+	return "elo"
+}
+
+func (e *Elo) Initial() State {
+	return State{Rating: e.Start}
+}
+
+func (e *Elo) Update(states []State, placements []int) []State {
+	updated := make([]State, len(states))
+	for i := range states {
+		delta := 0.0
+		for j := range states {
+			if i == j {
+				continue
+			}
+			expected := 1 / (1 + math.Pow(10, (states[j].Rating-states[i].Rating)/400))
+			actual := 0.5
+			if placements[i] < placements[j] {
+				actual = 1
+			} else if placements[i] > placements[j] {
+				actual = 0
+			}
+			delta += e.K * (actual - expected)
+		}
+		updated[i] = states[i]
+		updated[i].Rating += delta
+	}
+	return updated
+}
+
+func (e *Elo) Rank(s State) string {
+	return fmt.Sprintf("R%d", int(math.Round(s.Rating)))
+}
+
+func (e *Elo) Value(s State) float64 {
+	return s.Rating
+}
+
+func (e *Elo) Step() float64 {
+	return 100
+}
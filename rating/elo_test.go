@@ -0,0 +1,60 @@
+package rating
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEloUpdate(t *testing.T) {
+	elo := NewElo()
+	tests := []struct {
+		name       string
+		ratings    []float64
+		placements []int
+		want       []float64
+	}{
+		{"equal ratings", []float64{1500, 1500, 1500, 1500}, []int{1, 2, 3, 4}, []float64{1524, 1508, 1492, 1476}},
+		{"all tied", []float64{1500, 1500, 1500, 1500}, []int{1, 1, 1, 1}, []float64{1500, 1500, 1500, 1500}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			states := make([]State, len(tt.ratings))
+			for i, r := range tt.ratings {
+				states[i] = State{Rating: r}
+			}
+			for i, s := range elo.Update(states, tt.placements) {
+				if math.Abs(s.Rating-tt.want[i]) > 1e-9 {
+					t.Errorf("seat %d rating = %v, want %v", i, s.Rating, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestEloUpdateIsZeroSum(t *testing.T) {
+	elo := NewElo()
+	states := []State{{Rating: 1800}, {Rating: 1600}, {Rating: 1400}, {Rating: 1200}}
+	total := 0.0
+	for i, s := range elo.Update(states, []int{4, 3, 2, 1}) {
+		total += s.Rating - states[i].Rating
+	}
+	if math.Abs(total) > 1e-9 {
+		t.Errorf("ratings changed by %v in total, want 0", total)
+	}
+}
+
+func TestEloUpsetGainsMore(t *testing.T) {
+	elo := NewElo()
+	states := []State{{Rating: 1300}, {Rating: 1700}, {Rating: 1500}, {Rating: 1500}}
+	updated := elo.Update(states, []int{1, 2, 3, 4})
+	reversed := elo.Update([]State{states[1], states[0], states[2], states[3]}, []int{1, 2, 3, 4})
+	if gain, favourite := updated[0].Rating-1300, reversed[0].Rating-1700; gain <= favourite {
+		t.Errorf("underdog gained %v, favourite gained %v", gain, favourite)
+	}
+}
+
+func TestEloRank(t *testing.T) {
+	if got := NewElo().Rank(State{Rating: 1523.6}); got != "R1524" {
+		t.Errorf("Rank() = %q, want R1524", got)
+	}
+}
@@ -0,0 +1,51 @@
+package rating
+
+import (
+	"errors"
+	"sort"
+)
+
+var ErrUnknownSystem = errors.New("unknown rating system")
+
+// State is the rating of a player, every System only uses the fields it needs
+type State struct {
+	Rating float64 `json:"rating"` // elo rating
+	Level  int     `json:"level"`  // index of the dan/kyu rank
+	Points int     `json:"points"` // points towards the next rank
+}
+
+// System updates the rating of the players after a finished match
+type System interface {
+	Name() string
+	Initial() State
+	// Update returns the new states, placements are 1 to 4 and in the same order as states
+	Update(states []State, placements []int) []State
+	// Rank is the human-readable rank shown next to the player name
+	Rank(s State) string
+	// Value orders players by strength, higher is stronger
+	Value(s State) float64
+	// Step is the Value difference between players one skill level apart,
+	// matchmaking widens its rating band in multiples of it
+	Step() float64
+}
+
+var systems = map[string]func() System{
+	"elo":    func() System { return NewElo() },
+	"tenhou": func() System { return NewTenhou() },
+}
+
+func New(name string) (System, error) {
+	if f, ok := systems[name]; ok {
+		return f(), nil
+	}
+	return nil, ErrUnknownSystem
+}
+
+func Names() []string {
+	names := make([]string, 0, len(systems))
+	for name := range systems {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
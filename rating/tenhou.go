@@ -0,0 +1,93 @@
+package rating
+
+type tenhouRank struct {
+	Name string
+	// Start is the points a player has after reaching the rank
+	Start int
+	// Promote is the points needed for the next rank, 0 means the top rank
+	Promote int
+	// Fourth is the points lost for finishing 4th, ranks without a penalty can't be demoted
+	Fourth int
+}
+
+// tenhouRanks approximates the hanchan table of the Tenhou "tokujou" lobby
+var tenhouRanks = []tenhouRank{
+	{Name: "Newcomer", Start: 0, Promote: 20},
+	{Name: "9 kyu", Start: 0, Promote: 20},
+	{Name: "8 kyu", Start: 0, Promote: 20},
+	{Name: "7 kyu", Start: 0, Promote: 20},
+	{Name: "6 kyu", Start: 0, Promote: 40},
+	{Name: "5 kyu", Start: 0, Promote: 60},
+	{Name: "4 kyu", Start: 0, Promote: 80},
+	{Name: "3 kyu", Start: 0, Promote: 100},
+	{Name: "2 kyu", Start: 0, Promote: 100},
+	{Name: "1 kyu", Start: 0, Promote: 100},
+	{Name: "1 dan", Start: 200, Promote: 400, Fourth: 45},
+	{Name: "2 dan", Start: 400, Promote: 800, Fourth: 60},
+	{Name: "3 dan", Start: 600, Promote: 1200, Fourth: 75},
+	{Name: "4 dan", Start: 800, Promote: 1600, Fourth: 90},
+	{Name: "5 dan", Start: 1000, Promote: 2000, Fourth: 105},
+	{Name: "6 dan", Start: 1200, Promote: 2400, Fourth: 120},
+	{Name: "7 dan", Start: 1400, Promote: 2800, Fourth: 135},
+	{Name: "8 dan", Start: 1600, Promote: 3200, Fourth: 150},
+	{Name: "9 dan", Start: 1800, Promote: 3600, Fourth: 165},
+	{Name: "10 dan", Start: 2000, Promote: 4000, Fourth: 180},
+	{Name: "Tenhou", Start: 0, Promote: 0, Fourth: 0},
+}
+
+// tenhouPlacePoints are the points for finishing 1st, 2nd and 3rd
+var tenhouPlacePoints = [3]int{75, 30, 0}
+
+// Tenhou is a dan/pt system, players are promoted when their points reach the
+// threshold of their rank and demoted from 2 dan and above when their points drop below zero
+type Tenhou struct{}
+
+func NewTenhou() *Tenhou {
+	return &Tenhou{}
+}
+
+func (t *Tenhou) Name() string {
+	return "tenhou"
+}
+
+func (t *Tenhou) Initial() State {
+	return State{}
+}
+
+func (t *Tenhou) Update(states []State, placements []int) []State {
+	updated := make([]State, len(states))
+	for i, s := range states {
+		rank := tenhouRanks[s.Level]
+		if placements[i] < 4 {
+			s.Points += tenhouPlacePoints[placements[i]-1]
+		} else {
+			s.Points -= rank.Fourth
+		}
+		switch {
+		case rank.Promote > 0 && s.Points >= rank.Promote:
+			s.Level++
+			s.Points = tenhouRanks[s.Level].Start
+		case s.Points < 0 && rank.Fourth > 0:
+			if s.Level > 0 && tenhouRanks[s.Level-1].Fourth > 0 {
+				s.Level--
+				s.Points = tenhouRanks[s.Level].Start
+			} else {
+				s.Points = 0
+			}
+		}
+		updated[i] = s
+	}
+	return updated
+}
+
+func (t *Tenhou) Rank(s State) string {
+	return tenhouRanks[s.Level].Name
+}
+
+func (t *Tenhou) Value(s State) float64 {
+	return float64(s.Level*10000 + s.Points)
+}
+
+func (t *Tenhou) Step() float64 {
+	return 10000
+}
@@ -0,0 +1,77 @@
+package rating
+
+import "testing"
+
+// levels of the ranks used by the tests
+const (
+	level1Kyu = 9
+	level1Dan = 10
+	level2Dan = 11
+	level3Dan = 12
+	levelTop  = 20
+)
+
+func TestTenhouRankLevels(t *testing.T) {
+	tests := []struct {
+		level int
+		name  string
+	}{
+		{level1Kyu, "1 kyu"},
+		{level1Dan, "1 dan"},
+		{level2Dan, "2 dan"},
+		{level3Dan, "3 dan"},
+		{levelTop, "Tenhou"},
+	}
+	tenhou := NewTenhou()
+	for _, tt := range tests {
+		if got := tenhou.Rank(State{Level: tt.level}); got != tt.name {
+			t.Errorf("Rank(level %d) = %q, want %q", tt.level, got, tt.name)
+		}
+	}
+}
+
+func TestTenhouUpdate(t *testing.T) {
+	tests := []struct {
+		name      string
+		before    State
+		placement int
+		want      State
+	}{
+		{"2 dan fourth just above zero", State{Level: level2Dan, Points: 60}, 4, State{Level: level2Dan, Points: 0}},
+		{"2 dan fourth below zero is demoted", State{Level: level2Dan, Points: 59}, 4, State{Level: level1Dan, Points: 200}},
+		{"3 dan fourth below zero is demoted", State{Level: level3Dan, Points: 10}, 4, State{Level: level2Dan, Points: 400}},
+		{"1 dan is never demoted", State{Level: level1Dan, Points: 0}, 4, State{Level: level1Dan, Points: 0}},
+		{"kyu fourth loses nothing", State{Level: level1Kyu, Points: 50}, 4, State{Level: level1Kyu, Points: 50}},
+		{"third keeps the points", State{Level: level2Dan, Points: 100}, 3, State{Level: level2Dan, Points: 100}},
+		{"1 kyu promoted to 1 dan", State{Level: level1Kyu, Points: 70}, 2, State{Level: level1Dan, Points: 200}},
+		{"1 dan just below promotion", State{Level: level1Dan, Points: 324}, 1, State{Level: level1Dan, Points: 399}},
+		{"1 dan promoted to 2 dan", State{Level: level1Dan, Points: 325}, 1, State{Level: level2Dan, Points: 400}},
+		{"top rank is never promoted", State{Level: levelTop, Points: 5000}, 1, State{Level: levelTop, Points: 5075}},
+		{"top rank is never demoted", State{Level: levelTop, Points: 0}, 4, State{Level: levelTop, Points: 0}},
+	}
+	tenhou := NewTenhou()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			others := []State{tenhou.Initial(), tenhou.Initial(), tenhou.Initial()}
+			placements := []int{tt.placement}
+			for p := 1; p <= 4; p++ {
+				if p != tt.placement {
+					placements = append(placements, p)
+				}
+			}
+			got := tenhou.Update(append([]State{tt.before}, others...), placements)
+			if got[0] != tt.want {
+				t.Errorf("Update(%+v, place %d) = %+v, want %+v", tt.before, tt.placement, got[0], tt.want)
+			}
+		})
+	}
+}
+
+func TestTenhouValueOrdersRanksFirst(t *testing.T) {
+	tenhou := NewTenhou()
+	low := State{Level: level1Dan, Points: 399}
+	high := State{Level: level2Dan, Points: 0}
+	if tenhou.Value(low) >= tenhou.Value(high) {
+		t.Errorf("Value(%+v) >= Value(%+v)", low, high)
+	}
+}
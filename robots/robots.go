@@ -2,6 +2,7 @@ package robots
 
 import (
 	"errors"
+	"fmt"
 	"github.com/hphphp123321/mahjong-goserver/player"
 )
 
@@ -13,10 +14,21 @@ func RegisterRobot(name string, robot func() player.GameAgent) {
 	RobotsRegistry[name] = robot
 }
 
+// GetRobot creates the robot, its decisions are measured
 func GetRobot(name string) (player.GameAgent, error) {
 	if f, ok := RobotsRegistry[name]; ok {
-		return f(), nil
+		return &timedAgent{name: name, agent: f()}, nil
 	} else {
 		return nil, errors.New("robot not found")
 	}
 }
+
+// Alias registers an existing robot under another name
+func Alias(name string, use string) error {
+	f, ok := RobotsRegistry[use]
+	if !ok {
+		return fmt.Errorf("robot %s not found for alias %s", use, name)
+	}
+	RobotsRegistry[name] = f
+	return nil
+}
@@ -0,0 +1,53 @@
+package robots
+
+import (
+	"context"
+	"github.com/hphphp123321/mahjong-goserver/metrics"
+	"github.com/hphphp123321/mahjong-goserver/player"
+	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	"github.com/hphphp123321/mahjong-goserver/tracing"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	decisionSeconds = metrics.Default.NewHistogram("mahjong_robot_decision_seconds",
+		"Time robots take to choose an action.", metrics.DefBuckets, "robot")
+	decisionTimeouts = metrics.Default.NewCounter("mahjong_robot_decision_timeouts_total",
+		"Robot decisions that took longer than the decision timeout.", "robot")
+)
+
+// decisionTimeout is in nanoseconds so it can change while robots play
+var decisionTimeout atomic.Int64
+
+func init() {
+	decisionTimeout.Store(int64(10 * time.Second))
+}
+
+// SetDecisionTimeout sets how long a robot decision may take before it is counted as a timeout,
+// the robot is never interrupted, 0 counts no timeouts
+func SetDecisionTimeout(d time.Duration) {
+	decisionTimeout.Store(int64(d))
+}
+
+// timedAgent measures the decisions of a robot
+type timedAgent struct {
+	name  string
+	agent player.GameAgent
+}
+
+// ChooseAction is a robot decision span, the agent interface carries no context so the span is a root
+func (t *timedAgent) ChooseAction() (action *pb.Action, err error) {
+	_, span := tracing.Tracer().Start(context.Background(), tracing.SpanRobotDecision)
+	span.SetAttributes(tracing.RobotKey.String(t.name))
+	defer func() { tracing.End(span, err) }()
+
+	start := time.Now()
+	action, err = t.agent.ChooseAction()
+	elapsed := time.Since(start)
+	decisionSeconds.Observe(elapsed.Seconds(), t.name)
+	if timeout := time.Duration(decisionTimeout.Load()); timeout > 0 && elapsed > timeout {
+		decisionTimeouts.Inc(t.name)
+	}
+	return action, err
+}
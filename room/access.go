@@ -0,0 +1,78 @@
+package room
+
+import (
+	"crypto/rand"
+	"errors"
+	"golang.org/x/crypto/bcrypt"
+	"math/big"
+)
+
+var (
+	ErrWrongPassword    = errors.New("wrong room password")
+	ErrPasswordRequired = errors.New("room password required")
+	ErrPrivateRoom      = errors.New("room is private, an invite code or password is required")
+)
+
+// inviteAlphabet leaves out characters that are easy to mistake for each other
+const inviteAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+const InviteCodeLength = 6
+
+// NewInviteCode returns a short random code players can type to join a room
+func NewInviteCode() (string, error) {
+	code := make([]byte, InviteCodeLength)
+	max := big.NewInt(int64(len(inviteAlphabet)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = inviteAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// SetPassword protects the room with a password, an empty password removes it
+func (r *Room) SetPassword(password string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if password == "" {
+		r.passwordHash = nil
+		return nil
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	r.passwordHash = hash
+	return nil
+}
+
+func (r *Room) HasPassword() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.passwordHash != nil
+}
+
+// CheckAccess decides if a player may join with the given invite code or password,
+// the invite code always grants access, public rooms without a password are open to everyone
+func (r *Room) CheckAccess(inviteCode string, password string) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if inviteCode != "" && inviteCode == r.InviteCode {
+		return nil
+	}
+	if r.passwordHash == nil {
+		if r.Private {
+			return ErrPrivateRoom
+		}
+		return nil
+	}
+	if password == "" {
+		return ErrPasswordRequired
+	}
+	if bcrypt.CompareHashAndPassword(r.passwordHash, []byte(password)) != nil {
+		return ErrWrongPassword
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package room
+
+import (
+	"errors"
+	"github.com/hphphp123321/mahjong-goserver/player"
+	"sort"
+)
+
+var (
+	ErrNotOwner    = errors.New("only the room owner can do this")
+	ErrInvalidSeat = errors.New("invalid seat")
+	ErrSelf        = errors.New("can't target yourself")
+	ErrBanned      = errors.New("banned from this room")
+	ErrNotHuman    = errors.New("robots can't own a room")
+)
+
+// SwapSeats exchanges the players of two seats, one of them may be idle
+func (r *Room) SwapSeats(by *player.Player, a int, b int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkState(StateWaiting); err != nil {
+		return err
+	}
+	if by != r.Owner {
+		return ErrNotOwner
+	}
+	if !validSeat(a) || !validSeat(b) || a == b {
+		return ErrInvalidSeat
+	}
+	pa, pb := r.playerBySeat(a), r.playerBySeat(b)
+	if pa == nil && pb == nil {
+		return ErrSeatEmpty
+	}
+	if pa != nil {
+		pa.Seat = b
+	}
+	if pb != nil {
+		pb.Seat = a
+	}
+	if pa == nil || pb == nil {
+		r.swapIdleSeat(a, b)
+	}
+	return nil
+}
+
+// MoveSeat moves the player to an idle seat
+func (r *Room) MoveSeat(p *player.Player, seat int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkState(StateWaiting); err != nil {
+		return err
+	}
+	if !validSeat(seat) {
+		return ErrInvalidSeat
+	}
+	if r.playerBySeat(p.Seat) != p {
+		return ErrPlayerNotFound
+	}
+	if r.playerBySeat(seat) != nil {
+		return ErrSeatUsed
+	}
+	r.swapIdleSeat(seat, p.Seat)
+	p.Seat = seat
+	return nil
+}
+
+// Kick removes the player of the seat, a banned player can't join the room again
+func (r *Room) Kick(by *player.Player, seat int, ban bool) (kicked *player.Player, err error) {
+	r.mu.Lock()
+	defer r.changed(&err)
+	defer r.mu.Unlock()
+	if err := r.checkState(StateWaiting); err != nil {
+		return nil, err
+	}
+	if by != r.Owner {
+		return nil, ErrNotOwner
+	}
+	kicked = r.playerBySeat(seat)
+	if kicked == nil {
+		return nil, ErrSeatEmpty
+	}
+	if kicked == by {
+		return nil, ErrSelf
+	}
+	for i, v := range r.Players {
+		if v == kicked {
+			r.Players = append(r.Players[:i], r.Players[i+1:]...)
+			break
+		}
+	}
+	r.PlayerCount--
+	r.IdleSeats = append(r.IdleSeats, seat)
+	if ban && !kicked.IsRobot() {
+		if r.banned == nil {
+			r.banned = make(map[string]bool)
+		}
+		r.banned[kicked.PlayerName] = true
+	}
+	return kicked, nil
+}
+
+// TransferOwner hands the room over to the human player of the seat
+func (r *Room) TransferOwner(by *player.Player, seat int) (*player.Player, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkState(StateWaiting, StateFinished); err != nil {
+		return nil, err
+	}
+	if by != r.Owner {
+		return nil, ErrNotOwner
+	}
+	owner := r.playerBySeat(seat)
+	if owner == nil {
+		return nil, ErrSeatEmpty
+	}
+	if owner == by {
+		return nil, ErrSelf
+	}
+	if owner.IsRobot() {
+		return nil, ErrNotHuman
+	}
+	r.Owner = owner
+	return owner, nil
+}
+
+func (r *Room) IsBanned(playerName string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.banned[playerName]
+}
+
+// Banned returns the names of the players banned from the room
+func (r *Room) Banned() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.banned))
+	for name := range r.banned {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *Room) playerBySeat(seat int) *player.Player {
+	for _, v := range r.Players {
+		if v.Seat == seat {
+			return v
+		}
+	}
+	return nil
+}
+
+// swapIdleSeat replaces the idle seat from with to
+func (r *Room) swapIdleSeat(from int, to int) {
+	for i, s := range r.IdleSeats {
+		if s == from {
+			r.IdleSeats[i] = to
+			return
+		}
+	}
+}
+
+func validSeat(seat int) bool {
+	return seat >= 0 && seat < 4
+}
@@ -0,0 +1,94 @@
+package room
+
+import (
+	"github.com/hphphp123321/mahjong-goserver/player"
+)
+
+// RematchVote is the state of the rematch vote after a vote
+type RematchVote struct {
+	Votes   int
+	Needed  int
+	Decided bool
+	// Accepted is true when every seated player accepted
+	Accepted bool
+}
+
+// RecordMatch adds a finished match to the session, the adjusted scores are filled in
+// with the scoring of the session, and opens the rematch vote. It returns a copy of the session
+func (r *Room) RecordMatch(m SessionMatch) *Session {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range m.Results {
+		m.Results[i].Adjusted = r.Session.Scoring.Adjust(m.Results[i].Score, m.Results[i].Placement)
+	}
+	r.Session.Matches = append(r.Session.Matches, m)
+	r.votes = make(map[int]bool)
+	return r.sessionCopy()
+}
+
+// SessionTable returns a copy of the session
+func (r *Room) SessionTable() *Session {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.sessionCopy()
+}
+
+// sessionCopy copies the session with the privacy of the room, r has to be locked
+func (r *Room) sessionCopy() *Session {
+	s := r.Session.copy()
+	s.Private = r.Private
+	return s
+}
+
+// VoteRematch records the vote of a player of a finished room, a single decline decides the vote
+func (r *Room) VoteRematch(p *player.Player, accept bool) (RematchVote, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkState(StateFinished); err != nil {
+		return RematchVote{}, err
+	}
+	if r.playerBySeat(p.Seat) != p {
+		return RematchVote{}, ErrPlayerNotFound
+	}
+	if r.votes == nil {
+		r.votes = make(map[int]bool)
+	}
+	r.votes[p.Seat] = accept
+	vote := RematchVote{Needed: r.PlayerCount}
+	for _, v := range r.Players {
+		if v.IsRobot() || r.votes[v.Seat] {
+			vote.Votes++
+		}
+	}
+	if !accept {
+		vote.Decided = true
+	} else if vote.Votes == vote.Needed {
+		vote.Decided = true
+		vote.Accepted = true
+	}
+	if vote.Decided {
+		r.votes = nil
+	}
+	return vote, nil
+}
+
+// ReplaceWithRobot gives the seat of a player leaving a finished room to a robot
+func (r *Room) ReplaceWithRobot(p *player.Player, robot *player.Player) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkState(StateFinished); err != nil {
+		return err
+	}
+	for i, v := range r.Players {
+		if v == p {
+			robot.Seat = p.Seat
+			r.Players[i] = robot
+			delete(r.votes, p.Seat)
+			if p == r.Owner {
+				r.Owner = r.firstHuman()
+			}
+			return nil
+		}
+	}
+	return ErrPlayerNotFound
+}
@@ -0,0 +1,29 @@
+package room
+
+import (
+	"github.com/hphphp123321/mahjong-goserver/player"
+	"sort"
+	"time"
+)
+
+// MatchResult is reported by the game engine when a match of the room ends
+type MatchResult struct {
+	StartedAt time.Time
+	Scores    [4]int // final points by seat
+	Stats     [4]player.HandStats
+	ReplayRef string
+}
+
+// Placements returns the placement (1 to 4) of every seat,
+// equal scores are ranked by seat order as the first dealer wins ties
+func (m *MatchResult) Placements() [4]int {
+	seats := []int{0, 1, 2, 3}
+	sort.SliceStable(seats, func(i, j int) bool {
+		return m.Scores[seats[i]] > m.Scores[seats[j]]
+	})
+	var placements [4]int
+	for i, seat := range seats {
+		placements[seat] = i + 1
+	}
+	return placements
+}
@@ -6,28 +6,81 @@ import (
 	"github.com/hphphp123321/mahjong-goserver/common"
 	"github.com/hphphp123321/mahjong-goserver/player"
 	"sync"
+	"time"
 )
 
+var (
+	ErrRoomFull       = errors.New("room is full")
+	ErrSeatUsed       = errors.New("seat already used")
+	ErrPlayerNotFound = errors.New("player not found")
+	ErrSeatEmpty      = errors.New("player in seat not found")
+)
+
+const DefaultRuleSet = "default"
+
 type Room struct {
 	RoomID      uuid.UUID      `json:"room_id"`
 	RoomName    string         `json:"room_name"`
 	PlayerCount int            `json:"player_count"`
 	Owner       *player.Player `json:"owner"`
+	RuleSet     string         `json:"rule_set"`
+	Private     bool           `json:"private"`
+	InviteCode  string         `json:"-"`
+
+	SeatDraw     SeatDraw `json:"seat_draw"`
+	SeatDrawSeed *int64   `json:"seat_draw_seed,omitempty"` // a random seed is drawn if nil
+
+	RematchSeating SeatDraw `json:"rematch_seating"`
+	ReplaceLeavers bool     `json:"replace_leavers"`
+	Session        *Session `json:"session"`
 
 	IdleSeats []int            `json:"idle_seats"`
 	Players   []*player.Player `json:"players"`
 
-	mu sync.RWMutex
+	state        State
+	matchID      uuid.UUID // of the match being started or played, the last one once finished
+	startedAt    time.Time // when the match of matchID started playing
+	hand         int       // of the running match, 0 before its first hand
+	passwordHash []byte
+	banned       map[string]bool
+	votes        map[int]bool
+	observer     func(r *Room)
+	mu           sync.RWMutex
+}
+
+// Observe sets the function told about the room after a player joined or left or the state changed,
+// it is called without the lock of the room held
+func (r *Room) Observe(observer func(r *Room)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.observer = observer
+}
+
+// changed calls the observer once the change succeeded, it is deferred before the unlock so it runs after it
+func (r *Room) changed(err *error) {
+	if *err != nil {
+		return
+	}
+	r.mu.RLock()
+	observer := r.observer
+	r.mu.RUnlock()
+	if observer != nil {
+		observer(r)
+	}
 }
 
-func (r *Room) AddRobot(p *player.Player) error {
+func (r *Room) AddRobot(p *player.Player) (err error) {
 	r.mu.Lock()
+	defer r.changed(&err)
 	defer r.mu.Unlock()
+	if err := r.checkState(StateWaiting); err != nil {
+		return err
+	}
 	if r.PlayerCount == 4 {
-		return errors.New("room is full")
+		return ErrRoomFull
 	}
 	if !common.Contain(p.Seat, r.IdleSeats) {
-		return errors.New("seat already used")
+		return ErrSeatUsed
 	}
 	r.Players = append(r.Players, p)
 	idleSeats, err := common.Remove(p.Seat, r.IdleSeats)
@@ -39,11 +92,18 @@ func (r *Room) AddRobot(p *player.Player) error {
 	return nil
 }
 
-func (r *Room) AddPlayer(p *player.Player) error {
+func (r *Room) AddPlayer(p *player.Player) (err error) {
 	r.mu.Lock()
+	defer r.changed(&err)
 	defer r.mu.Unlock()
+	if err := r.checkState(StateWaiting); err != nil {
+		return err
+	}
 	if r.PlayerCount == 4 {
-		return errors.New("room is full")
+		return ErrRoomFull
+	}
+	if r.banned[p.PlayerName] {
+		return ErrBanned
 	}
 	r.Players = append(r.Players, p)
 	p.Seat = r.IdleSeats[0]
@@ -52,9 +112,14 @@ func (r *Room) AddPlayer(p *player.Player) error {
 	return nil
 }
 
-func (r *Room) RemovePlayer(p *player.Player) error {
+// RemovePlayer takes the player out of the room, players can't leave a game that is running
+func (r *Room) RemovePlayer(p *player.Player) (err error) {
 	r.mu.Lock()
+	defer r.changed(&err)
 	defer r.mu.Unlock()
+	if err := r.checkState(StateWaiting, StateFinished, StateClosed); err != nil {
+		return err
+	}
 	for i, v := range r.Players {
 		if v.Token == p.Token {
 			r.Players = append(r.Players[:i], r.Players[i+1:]...)
@@ -64,16 +129,12 @@ func (r *Room) RemovePlayer(p *player.Player) error {
 			//	r.OwnerName = r.Players[0].PlayerName
 			//}
 			if p == r.Owner {
-				if r.PlayerCount > 0 {
-					r.Owner = r.Players[0]
-				} else {
-					r.Owner = nil
-				}
+				r.Owner = r.firstHuman()
 			}
 			return nil
 		}
 	}
-	return errors.New("player not found")
+	return ErrPlayerNotFound
 }
 
 func (r *Room) GetPlayerBySeat(seat int) (*player.Player, error) {
@@ -84,7 +145,7 @@ func (r *Room) GetPlayerBySeat(seat int) (*player.Player, error) {
 			return v, nil
 		}
 	}
-	return nil, errors.New("player in seat not found")
+	return nil, ErrSeatEmpty
 }
 
 func (r *Room) IsFull() bool {
@@ -93,6 +154,28 @@ func (r *Room) IsFull() bool {
 	return r.PlayerCount == 4
 }
 
+// HumanCount returns the number of players that are not robots
+func (r *Room) HumanCount() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	n := 0
+	for _, v := range r.Players {
+		if !v.IsRobot() {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *Room) firstHuman() *player.Player {
+	for _, v := range r.Players {
+		if !v.IsRobot() {
+			return v
+		}
+	}
+	return nil
+}
+
 func (r *Room) IsEmpty() bool {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -118,7 +201,7 @@ func (r *Room) GetSeat(p *player.Player) (int, error) {
 			return v.Seat, nil
 		}
 	}
-	return -1, errors.New("player not found")
+	return -1, ErrPlayerNotFound
 }
 
 func NewRoom(roomID uuid.UUID, roomName string, owner *player.Player) *Room {
@@ -127,6 +210,10 @@ func NewRoom(roomID uuid.UUID, roomName string, owner *player.Player) *Room {
 		RoomName:    roomName,
 		PlayerCount: 0,
 		Owner:       owner,
+		RuleSet:     DefaultRuleSet,
 		IdleSeats:   []int{0, 1, 2, 3},
+
+		RematchSeating: SeatDrawRotate,
+		Session:        NewSession(roomID, roomName),
 	}
 }
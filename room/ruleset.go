@@ -0,0 +1,38 @@
+package room
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrUnknownRuleSet = errors.New("unknown rule set")
+
+var seatDrawNames = map[string]SeatDraw{
+	"none":   SeatDrawNone,
+	"random": SeatDrawRandom,
+	"wind":   SeatDrawWind,
+	"rotate": SeatDrawRotate,
+}
+
+// ParseSeatDraw returns the seat draw of a name used in config files: none, random, wind or rotate
+func ParseSeatDraw(name string) (SeatDraw, error) {
+	if d, ok := seatDrawNames[name]; ok {
+		return d, nil
+	}
+	return SeatDrawNone, fmt.Errorf("unknown seat draw %q, use none, random, wind or rotate", name)
+}
+
+// RuleSet is a preset rooms of the rule set start with, settings of the create room request win over it
+type RuleSet struct {
+	Name     string
+	SeatDraw SeatDraw
+	Scoring  Scoring
+}
+
+// Apply sets the seat draw and scoring of the preset on the room
+func (rs RuleSet) Apply(r *Room) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.SeatDraw = rs.SeatDraw
+	r.Session.Scoring = rs.Scoring
+}
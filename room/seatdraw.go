@@ -0,0 +1,163 @@
+package room
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"github.com/hphphp123321/mahjong-goserver/player"
+	mrand "math/rand"
+	"sort"
+)
+
+// SeatDraw is how seats are assigned when a game starts
+type SeatDraw int
+
+const (
+	SeatDrawNone   SeatDraw = iota // keep the seats players joined in
+	SeatDrawRandom                 // shuffle the seats
+	SeatDrawWind                   // every player draws a wind tile, kaze-gime
+	SeatDrawRotate                 // every player moves one seat, the south player becomes east
+)
+
+// SeatAssignment is the seat a player got from the draw, Tile is the wind drawn
+// for SeatDrawWind and the seat wind otherwise
+type SeatAssignment struct {
+	Player *player.Player
+	From   int
+	Seat   int
+	Tile   int
+	Order  int // when the player drew the tile, 1 is first, 0 for the modes without tiles
+}
+
+// SeatDrawResult is the outcome of a draw, Dice are the dice rolled by the temporary east of SeatDrawWind
+type SeatDrawResult struct {
+	Dice  [2]int
+	Seats []SeatAssignment
+}
+
+// Seating is the seat of every player and the idle seats of a room, taken before a draw to undo it
+type Seating struct {
+	seats map[*player.Player]int
+	idle  []int
+}
+
+// Seating returns the current seats of the room
+func (r *Room) Seating() *Seating {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s := &Seating{
+		seats: make(map[*player.Player]int, len(r.Players)),
+		idle:  append([]int(nil), r.IdleSeats...),
+	}
+	for _, p := range r.Players {
+		s.seats[p] = p.Seat
+	}
+	return s
+}
+
+// RestoreSeating puts back the seats of a starting room whose start failed
+func (r *Room) RestoreSeating(s *Seating) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkState(StateStarting); err != nil {
+		return err
+	}
+	for p, seat := range s.seats {
+		p.Seat = seat
+	}
+	r.IdleSeats = append(r.IdleSeats[:0], s.idle...)
+	return nil
+}
+
+// NewSeatDrawSeed returns a random seed for DrawSeats
+func NewSeatDrawSeed() (int64, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(b) >> 1), nil
+}
+
+// DrawSeats reassigns the seats of a starting room with the given mode,
+// the same seed and seating always give the same result
+func (r *Room) DrawSeats(mode SeatDraw, seed int64) (*SeatDrawResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkState(StateStarting); err != nil {
+		return nil, err
+	}
+	players := make([]*player.Player, len(r.Players))
+	copy(players, r.Players)
+	sort.Slice(players, func(i, j int) bool {
+		return players[i].Seat < players[j].Seat
+	})
+	rng := mrand.New(mrand.NewSource(seed))
+	result := &SeatDrawResult{}
+	seats := []int{0, 1, 2, 3}
+	orders := make([]int, len(players))
+	switch mode {
+	case SeatDrawRandom:
+		rng.Shuffle(len(seats), func(i, j int) {
+			seats[i], seats[j] = seats[j], seats[i]
+		})
+	case SeatDrawWind:
+		result.Dice = [2]int{rng.Intn(6) + 1, rng.Intn(6) + 1}
+		seats, orders = windDraw(players, result.Dice, rng)
+	case SeatDrawRotate:
+		for i, p := range players {
+			seats[i] = (p.Seat + 3) % 4
+		}
+	default:
+		for i, p := range players {
+			seats[i] = p.Seat
+		}
+	}
+
+	used := make(map[int]bool)
+	for i, p := range players {
+		result.Seats = append(result.Seats, SeatAssignment{Player: p, From: p.Seat, Seat: seats[i], Tile: seats[i], Order: orders[i]})
+		used[seats[i]] = true
+	}
+	for _, a := range result.Seats {
+		a.Player.Seat = a.Seat
+	}
+	r.IdleSeats = r.IdleSeats[:0]
+	for seat := 0; seat < 4; seat++ {
+		if !used[seat] {
+			r.IdleSeats = append(r.IdleSeats, seat)
+		}
+	}
+	return result, nil
+}
+
+// windDraw is kaze-gime: the four wind tiles are shuffled face down in a row and the player in
+// the lowest seat, the temporary east, rolls the dice. Counting counterclockwise from the
+// temporary east as 1, the player the dice point at draws the first tile and the others
+// follow counterclockwise. The tile drawn is the seat wind of the player. players are in seat order
+func windDraw(players []*player.Player, dice [2]int, rng *mrand.Rand) (seats []int, orders []int) {
+	tiles := []int{0, 1, 2, 3}
+	rng.Shuffle(len(tiles), func(i, j int) {
+		tiles[i], tiles[j] = tiles[j], tiles[i]
+	})
+	bySeat := make(map[int]int, len(players))
+	for i, p := range players {
+		bySeat[p.Seat] = i
+	}
+	seats = make([]int, len(players))
+	orders = make([]int, len(players))
+	if len(players) == 0 {
+		return seats, orders
+	}
+	// an empty seat the dice point at passes the first draw to the next player
+	first := players[0].Seat + dice[0] + dice[1] - 1
+	drawn := 0
+	for n := 0; n < 4 && drawn < len(players); n++ {
+		i, ok := bySeat[(first+n)%4]
+		if !ok {
+			continue
+		}
+		seats[i] = tiles[drawn]
+		drawn++
+		orders[i] = drawn
+	}
+	return seats, orders
+}
@@ -0,0 +1,173 @@
+package room
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var ErrInvalidScoring = errors.New("uma needs one value for every placement")
+
+// Scoring turns the final points of a match into uma-adjusted scores
+type Scoring struct {
+	StartPoints  int        `json:"start_points"`
+	ReturnPoints int        `json:"return_points"`
+	Uma          [4]float64 `json:"uma"` // for 1st to 4th, in thousands of points
+}
+
+// DefaultScoring is 25000 start, 30000 return and 10-20 uma
+func DefaultScoring() Scoring {
+	return Scoring{
+		StartPoints:  25000,
+		ReturnPoints: 30000,
+		Uma:          [4]float64{20, 10, -10, -20},
+	}
+}
+
+func NewScoring(startPoints int, returnPoints int, uma []float64) (Scoring, error) {
+	if len(uma) != 4 {
+		return Scoring{}, ErrInvalidScoring
+	}
+	s := Scoring{StartPoints: startPoints, ReturnPoints: returnPoints}
+	copy(s.Uma[:], uma)
+	return s, nil
+}
+
+// Adjust returns the score in thousands of points after return points and uma,
+// 1st also takes the oka paid by every player
+func (s Scoring) Adjust(score int, placement int) float64 {
+	v := float64(score-s.ReturnPoints)/1000 + s.Uma[placement-1]
+	if placement == 1 {
+		v += float64(s.ReturnPoints-s.StartPoints) * 4 / 1000
+	}
+	return v
+}
+
+type SessionResult struct {
+	PlayerName string  `json:"player_name"`
+	Seat       int     `json:"seat"`
+	Robot      bool    `json:"robot"`
+	Score      int     `json:"score"`
+	Placement  int     `json:"placement"`
+	Adjusted   float64 `json:"adjusted"`
+}
+
+type SessionMatch struct {
+	MatchID    uuid.UUID       `json:"match_id"`
+	FinishedAt time.Time       `json:"finished_at"`
+	Results    []SessionResult `json:"results"`
+}
+
+type SessionStanding struct {
+	PlayerName string  `json:"player_name"`
+	Robot      bool    `json:"robot"`
+	Matches    int     `json:"matches"`
+	Score      int     `json:"score"`
+	Adjusted   float64 `json:"adjusted"`
+	Placements [4]int  `json:"placements"`
+}
+
+// Session is every match played at the table of a room, it outlives rematches
+// and is kept by the store after the room closes
+type Session struct {
+	RoomID    uuid.UUID      `json:"room_id"`
+	RoomName  string         `json:"room_name"`
+	StartedAt time.Time      `json:"started_at"`
+	Scoring   Scoring        `json:"scoring"`
+	Matches   []SessionMatch `json:"matches"`
+	Private   bool           `json:"private"` // of the room, only its players may read it
+}
+
+func NewSession(roomID uuid.UUID, roomName string) *Session {
+	return &Session{
+		RoomID:    roomID,
+		RoomName:  roomName,
+		StartedAt: time.Now(),
+		Scoring:   DefaultScoring(),
+	}
+}
+
+// Standings sums the matches by player, best adjusted score first
+func (s *Session) Standings() []SessionStanding {
+	byName := make(map[string]*SessionStanding)
+	standings := make([]*SessionStanding, 0)
+	for _, m := range s.Matches {
+		for _, res := range m.Results {
+			st, ok := byName[res.PlayerName]
+			if !ok {
+				st = &SessionStanding{PlayerName: res.PlayerName, Robot: res.Robot}
+				byName[res.PlayerName] = st
+				standings = append(standings, st)
+			}
+			st.Matches++
+			st.Score += res.Score
+			st.Adjusted += res.Adjusted
+			st.Placements[res.Placement-1]++
+		}
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].Adjusted != standings[j].Adjusted {
+			return standings[i].Adjusted > standings[j].Adjusted
+		}
+		return standings[i].PlayerName < standings[j].PlayerName
+	})
+	result := make([]SessionStanding, 0, len(standings))
+	for _, st := range standings {
+		result = append(result, *st)
+	}
+	return result
+}
+
+// WriteCSV writes the standings table
+func (s *Session) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := []string{"rank", "player", "robot", "matches", "score", "adjusted", "1st", "2nd", "3rd", "4th"}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for i, st := range s.Standings() {
+		record := []string{
+			strconv.Itoa(i + 1),
+			st.PlayerName,
+			strconv.FormatBool(st.Robot),
+			strconv.Itoa(st.Matches),
+			strconv.Itoa(st.Score),
+			fmt.Sprintf("%.1f", st.Adjusted),
+		}
+		for _, n := range st.Placements {
+			record = append(record, strconv.Itoa(n))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// HasPlayer tells if the player played a match of the session
+func (s *Session) HasPlayer(playerName string) bool {
+	for _, m := range s.Matches {
+		for _, res := range m.Results {
+			if !res.Robot && res.PlayerName == playerName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *Session) copy() *Session {
+	c := *s
+	c.Matches = make([]SessionMatch, len(s.Matches))
+	for i, m := range s.Matches {
+		c.Matches[i] = m
+		c.Matches[i].Results = append([]SessionResult(nil), m.Results...)
+	}
+	return &c
+}
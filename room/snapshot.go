@@ -0,0 +1,147 @@
+package room
+
+import (
+	"github.com/google/uuid"
+	"github.com/hphphp123321/mahjong-goserver/player"
+	"sort"
+)
+
+// SeatSnapshot is a seated player, robots are rebuilt from their name which is their level
+type SeatSnapshot struct {
+	PlayerName string    `json:"player_name"`
+	Token      uuid.UUID `json:"token"`
+	Seat       int       `json:"seat"`
+	Ready      bool      `json:"ready"`
+	Rank       string    `json:"rank"`
+	Robot      bool      `json:"robot"`
+}
+
+// Snapshot is everything needed to rebuild a room after a restart.
+// The game engine keeps no state in the room, so a running game can't be part of it
+type Snapshot struct {
+	RoomID         uuid.UUID      `json:"room_id"`
+	RoomName       string         `json:"room_name"`
+	RuleSet        string         `json:"rule_set"`
+	Private        bool           `json:"private"`
+	InviteCode     string         `json:"invite_code"`
+	SeatDraw       SeatDraw       `json:"seat_draw"`
+	SeatDrawSeed   *int64         `json:"seat_draw_seed,omitempty"`
+	RematchSeating SeatDraw       `json:"rematch_seating"`
+	ReplaceLeavers bool           `json:"replace_leavers"`
+	Session        *Session       `json:"session"`
+	State          State          `json:"state"`
+	PasswordHash   []byte         `json:"password_hash,omitempty"`
+	Banned         []string       `json:"banned,omitempty"`
+	Votes          map[int]bool   `json:"votes,omitempty"`
+	OwnerSeat      int            `json:"owner_seat"`
+	Seats          []SeatSnapshot `json:"seats"`
+}
+
+func (r *Room) Snapshot() Snapshot {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s := Snapshot{
+		RoomID:         r.RoomID,
+		RoomName:       r.RoomName,
+		RuleSet:        r.RuleSet,
+		Private:        r.Private,
+		InviteCode:     r.InviteCode,
+		SeatDraw:       r.SeatDraw,
+		SeatDrawSeed:   r.SeatDrawSeed,
+		RematchSeating: r.RematchSeating,
+		ReplaceLeavers: r.ReplaceLeavers,
+		Session:        r.Session.copy(),
+		State:          r.state,
+		PasswordHash:   r.passwordHash,
+		Votes:          make(map[int]bool, len(r.votes)),
+		OwnerSeat:      -1,
+	}
+	for name := range r.banned {
+		s.Banned = append(s.Banned, name)
+	}
+	sort.Strings(s.Banned)
+	for seat, accept := range r.votes {
+		s.Votes[seat] = accept
+	}
+	if r.Owner != nil {
+		s.OwnerSeat = r.Owner.Seat
+	}
+	for _, p := range r.Players {
+		s.Seats = append(s.Seats, SeatSnapshot{
+			PlayerName: p.PlayerName,
+			Token:      p.Token,
+			Seat:       p.Seat,
+			Ready:      p.Ready,
+			Rank:       p.Rank,
+			Robot:      p.IsRobot(),
+		})
+	}
+	sort.Slice(s.Seats, func(i, j int) bool {
+		return s.Seats[i].Seat < s.Seats[j].Seat
+	})
+	return s
+}
+
+// Restore rebuilds the room of the snapshot, newRobot creates the robot of a robot seat.
+// A room whose game was starting or running goes back to waiting with its humans not ready,
+// as the game itself was not saved
+func Restore(s Snapshot, newRobot func(level string, seat int) (*player.Player, error)) (*Room, error) {
+	r := NewRoom(s.RoomID, s.RoomName, nil)
+	r.RuleSet = s.RuleSet
+	r.Private = s.Private
+	r.InviteCode = s.InviteCode
+	r.SeatDraw = s.SeatDraw
+	r.SeatDrawSeed = s.SeatDrawSeed
+	r.RematchSeating = s.RematchSeating
+	r.ReplaceLeavers = s.ReplaceLeavers
+	if s.Session != nil {
+		r.Session = s.Session
+	}
+	r.passwordHash = s.PasswordHash
+	if len(s.Banned) > 0 {
+		r.banned = make(map[string]bool, len(s.Banned))
+		for _, name := range s.Banned {
+			r.banned[name] = true
+		}
+	}
+	interrupted := s.State == StateStarting || s.State == StatePlaying
+	r.state = s.State
+	if interrupted {
+		r.state = StateWaiting
+	} else if len(s.Votes) > 0 {
+		r.votes = s.Votes
+	}
+	for _, seat := range s.Seats {
+		if seat.Seat < 0 || seat.Seat > 3 || r.playerBySeat(seat.Seat) != nil {
+			return nil, ErrInvalidSeat
+		}
+		var p *player.Player
+		if seat.Robot {
+			var err error
+			if p, err = newRobot(seat.PlayerName, seat.Seat); err != nil {
+				return nil, err
+			}
+		} else {
+			p = player.NewPlayer(seat.PlayerName, seat.Token)
+			p.RoomID = r.RoomID
+			p.Seat = seat.Seat
+			p.Ready = seat.Ready && !interrupted
+			p.Rank = seat.Rank
+		}
+		r.Players = append(r.Players, p)
+		r.PlayerCount++
+		if seat.Seat == s.OwnerSeat && !seat.Robot {
+			r.Owner = p
+		}
+	}
+	r.IdleSeats = r.IdleSeats[:0]
+	for seat := 0; seat < 4; seat++ {
+		if r.playerBySeat(seat) == nil {
+			r.IdleSeats = append(r.IdleSeats, seat)
+		}
+	}
+	if r.Owner == nil {
+		r.Owner = r.firstHuman()
+	}
+	return r, nil
+}
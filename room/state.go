@@ -0,0 +1,135 @@
+package room
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrWrongState        = errors.New("not allowed in the current room state")
+	ErrInvalidTransition = errors.New("invalid room state transition")
+	ErrInvalidHand       = errors.New("hands are numbered from 1")
+)
+
+// State is the lifecycle stage of a room
+type State int
+
+const (
+	StateWaiting  State = iota // players join, leave and get ready
+	StateStarting              // the game is being set up, seats are drawn
+	StatePlaying               // the game engine owns the room
+	StateFinished              // the match ended, players may play again or leave
+	StateClosed                // the room is removed
+)
+
+var stateNames = map[State]string{
+	StateWaiting:  "waiting",
+	StateStarting: "starting",
+	StatePlaying:  "playing",
+	StateFinished: "finished",
+	StateClosed:   "closed",
+}
+
+func (s State) String() string {
+	if name, ok := stateNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// transitions lists the states every state may move to
+var transitions = map[State][]State{
+	StateWaiting:  {StateStarting, StateClosed},
+	StateStarting: {StatePlaying, StateWaiting, StateClosed},
+	StatePlaying:  {StateFinished, StateClosed},
+	StateFinished: {StateWaiting, StateClosed},
+	StateClosed:   {},
+}
+
+func (r *Room) State() State {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.state
+}
+
+// Transition moves the room to the state if the current state allows it
+func (r *Room) Transition(to State) (err error) {
+	r.mu.Lock()
+	defer r.changed(&err)
+	defer r.mu.Unlock()
+	return r.transition(to)
+}
+
+// CheckState returns an error naming the current state if it is not one of allowed
+func (r *Room) CheckState(allowed ...State) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.checkState(allowed...)
+}
+
+// MatchID identifies the match of the room in logs and the match history, it is nil while waiting
+func (r *Room) MatchID() uuid.UUID {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.matchID
+}
+
+// StartedAt returns when the running match started playing, zero before
+func (r *Room) StartedAt() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.startedAt
+}
+
+// StartHand records the hand the running match is playing, hands are numbered from 1
+func (r *Room) StartHand(hand int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkState(StatePlaying); err != nil {
+		return err
+	}
+	if hand < 1 {
+		return ErrInvalidHand
+	}
+	r.hand = hand
+	return nil
+}
+
+// Hand returns the hand the running match is playing, 0 before its first hand
+func (r *Room) Hand() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.hand
+}
+
+func (r *Room) transition(to State) error {
+	for _, s := range transitions[r.state] {
+		if s == to {
+			r.state = to
+			switch to {
+			case StateStarting:
+				r.matchID = uuid.New()
+			case StatePlaying:
+				r.startedAt = time.Now()
+				r.hand = 0
+			case StateWaiting:
+				r.matchID = uuid.Nil
+				r.startedAt = time.Time{}
+				r.hand = 0
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s to %s", ErrInvalidTransition, r.state, to)
+}
+
+func (r *Room) checkState(allowed ...State) error {
+	for _, s := range allowed {
+		if r.state == s {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: room is %s", ErrWrongState, r.state)
+}
@@ -0,0 +1,333 @@
+package v1
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"sort"
+	"strings"
+	"time"
+)
+
+// adminMethodPrefix marks the methods of the admin service, they are checked against the admin token
+const adminMethodPrefix = "/mahjong.MahjongAdmin/"
+
+func isAdminMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, adminMethodPrefix)
+}
+
+// SetAdminToken sets the credential of the admin service, an empty token disables the service
+func (s *MahjongServer) SetAdminToken(token string) {
+	s.adminMu.Lock()
+	s.adminToken = token
+	s.adminMu.Unlock()
+}
+
+// authenticateAdmin checks the admin token in the request metadata
+func (s *MahjongServer) authenticateAdmin(ctx context.Context) error {
+	s.adminMu.RLock()
+	token := s.adminToken
+	s.adminMu.RUnlock()
+	if token == "" {
+		return errAdminDisabled
+	}
+	headers, _ := metadata.FromIncomingContext(ctx)
+	tokens := headers.Get("admin-token")
+	if len(tokens) == 0 {
+		return errNoAdminToken
+	}
+	if subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(token)) != 1 {
+		return errNotAdmin
+	}
+	return nil
+}
+
+func (s *MahjongServer) isBanned(playerName string) bool {
+	s.adminMu.RLock()
+	defer s.adminMu.RUnlock()
+	_, ok := s.banned[playerName]
+	return ok
+}
+
+func (s *MahjongServer) getClientByName(playerName string) *client {
+	s.clientMu.RLock()
+	defer s.clientMu.RUnlock()
+	for _, c := range s.clients {
+		if c.p.PlayerName == playerName {
+			return c
+		}
+	}
+	return nil
+}
+
+func (s *MahjongServer) getRoom(roomID string) (*room.Room, error) {
+	id, err := uuid.Parse(roomID)
+	if err != nil {
+		return nil, errRoomNotFound
+	}
+	s.roomMu.RLock()
+	defer s.roomMu.RUnlock()
+	r, ok := s.rooms[id]
+	if !ok {
+		return nil, errRoomNotFound
+	}
+	return r, nil
+}
+
+// AdminServer implements the admin service on top of the game server
+type AdminServer struct {
+	pb.UnimplementedMahjongAdminServer
+	s *MahjongServer
+}
+
+func NewAdminServer(s *MahjongServer) *AdminServer {
+	return &AdminServer{s: s}
+}
+
+func (a *AdminServer) ListClients(ctx context.Context, in *pb.Empty) (*pb.ListClientsReply, error) {
+	a.s.clientMu.RLock()
+	clients := make([]*client, 0, len(a.s.clients))
+	for _, c := range a.s.clients {
+		clients = append(clients, c)
+	}
+	a.s.clientMu.RUnlock()
+	sort.Slice(clients, func(i, j int) bool {
+		return clients[i].p.PlayerName < clients[j].p.PlayerName
+	})
+	reply := &pb.ListClientsReply{Message: "list clients success"}
+	for _, c := range clients {
+		ac := &pb.AdminClient{
+			PlayerName:     c.p.PlayerName,
+			Session:        c.p.Token.String(),
+			Seat:           int32(c.p.Seat),
+			Ready:          c.p.Ready,
+			Rank:           c.p.Rank,
+			LastSeen:       c.lastSeen().Unix(),
+			HasReadyStream: c.getReadyStream() != nil,
+			HasStartStream: c.hasStartStream(),
+			InQueue:        a.s.queue.Contains(c.p.Token),
+		}
+		if c.p.RoomID != uuid.Nil {
+			ac.RoomID = c.p.RoomID.String()
+		}
+		reply.Clients = append(reply.Clients, ac)
+	}
+	return reply, nil
+}
+
+func (a *AdminServer) ListRooms(ctx context.Context, in *pb.Empty) (*pb.ListRoomsReply, error) {
+	a.s.roomMu.RLock()
+	rooms := make([]*room.Room, 0, len(a.s.rooms))
+	for _, r := range a.s.rooms {
+		rooms = append(rooms, r)
+	}
+	a.s.roomMu.RUnlock()
+	sort.Slice(rooms, func(i, j int) bool {
+		return rooms[i].RoomName < rooms[j].RoomName
+	})
+	reply := &pb.ListRoomsReply{Message: "list rooms success"}
+	for _, r := range rooms {
+		ar := &pb.AdminRoom{
+			Room:       newPbRoom(r),
+			InviteCode: r.InviteCode,
+			Banned:     r.Banned(),
+			MatchCount: int32(len(r.SessionTable().Matches)),
+		}
+		for _, p := range r.Players {
+			ar.Seats = append(ar.Seats, &pb.AdminSeat{
+				Seat:       int32(p.Seat),
+				PlayerName: p.PlayerName,
+				Robot:      p.IsRobot(),
+				Ready:      p.Ready,
+			})
+		}
+		sort.Slice(ar.Seats, func(i, j int) bool {
+			return ar.Seats[i].Seat < ar.Seats[j].Seat
+		})
+		if t := a.s.tournamentByRoom(r.RoomID); t != nil {
+			ar.TournamentID = t.ID.String()
+		}
+		reply.Rooms = append(reply.Rooms, ar)
+	}
+	return reply, nil
+}
+
+// KickPlayer logs the player out, a ban works for offline players too and lasts until UnbanPlayer
+func (a *AdminServer) KickPlayer(ctx context.Context, in *pb.AdminKickRequest) (*pb.AdminReply, error) {
+	if in.Ban {
+		a.s.adminMu.Lock()
+		a.s.banned[in.PlayerName] = in.Reason
+		a.s.adminMu.Unlock()
+	}
+	c := a.s.getClientByName(in.PlayerName)
+	if c == nil {
+		if in.Ban {
+			return &pb.AdminReply{Message: fmt.Sprintf("player: %s, is offline and banned", in.PlayerName)}, nil
+		}
+		return nil, errClientNotFound
+	}
+	msg := fmt.Sprintf("player: %s, kicked by admin: %s", in.PlayerName, in.Reason)
+	if err := c.sendReadyReply(&pb.ReadyReply{Message: msg}); err != nil && !errors.Is(err, errNoReadyStream) {
+		c.log().Warningf("send kick failed: %v", err)
+	}
+	if c.hasStartStream() {
+		if err := c.sendStartReply(&pb.StartReply{Message: msg}); err != nil {
+			c.log().Warningf("send kick failed: %v", err)
+		}
+	}
+	if err := a.s.endSession(c); err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey:      "AdminKick",
+		logging.PlayerNameKey: in.PlayerName,
+		"Ban":                 in.Ban,
+		"Reason":              in.Reason,
+	}).Info("player kicked by admin")
+	return &pb.AdminReply{Message: msg}, nil
+}
+
+func (a *AdminServer) UnbanPlayer(ctx context.Context, in *pb.AdminPlayerRequest) (*pb.AdminReply, error) {
+	a.s.adminMu.Lock()
+	_, ok := a.s.banned[in.PlayerName]
+	delete(a.s.banned, in.PlayerName)
+	a.s.adminMu.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "player: %s, is not banned", in.PlayerName)
+	}
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey:      "AdminUnban",
+		logging.PlayerNameKey: in.PlayerName,
+	}).Info("player unbanned by admin")
+	return &pb.AdminReply{Message: fmt.Sprintf("player: %s, unbanned", in.PlayerName)}, nil
+}
+
+func (a *AdminServer) CloseRoom(ctx context.Context, in *pb.AdminRoomRequest) (*pb.AdminReply, error) {
+	r, err := a.s.getRoom(in.RoomID)
+	if err != nil {
+		return nil, err
+	}
+	msg := fmt.Sprintf("room: %s, closed by admin: %s", r.RoomName, in.Reason)
+	_ = a.s.startBoardCast(r, &pb.StartReply{Message: msg})
+	a.s.closeRoom(r, msg)
+	roomLog(logging.FromContext(ctx), r).WithFields(log.Fields{
+		logging.EventKey: "AdminCloseRoom",
+		"Reason":         in.Reason,
+	}).Info("room closed by admin")
+	return &pb.AdminReply{Message: msg}, nil
+}
+
+// Announce sends the message on every open Ready and Start stream
+func (a *AdminServer) Announce(ctx context.Context, in *pb.AnnounceRequest) (*pb.AdminReply, error) {
+	n := a.s.announce(in.Message)
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey: "AdminAnnounce",
+		"Recipients":     n,
+	}).Info(in.Message)
+	return &pb.AdminReply{Message: fmt.Sprintf("announcement sent to %d players", n)}, nil
+}
+
+// announce returns the number of players that received the message on at least one stream
+func (s *MahjongServer) announce(msg string) int {
+	s.clientMu.RLock()
+	clients := make([]*client, 0, len(s.clients))
+	for _, c := range s.clients {
+		clients = append(clients, c)
+	}
+	s.clientMu.RUnlock()
+	announcement := &pb.Announcement{Message: msg, SentAt: time.Now().Unix()}
+	n := 0
+	for _, c := range clients {
+		sent := false
+		err := c.sendReadyReply(&pb.ReadyReply{
+			Message: msg,
+			Reply:   &pb.ReadyReply_Announcement{Announcement: announcement},
+		})
+		sent = err == nil
+		if c.hasStartStream() {
+			err := c.sendStartReply(&pb.StartReply{
+				Message: msg,
+				Reply:   &pb.StartReply_Announcement{Announcement: announcement},
+			})
+			sent = sent || err == nil
+		}
+		if sent {
+			n++
+		}
+	}
+	return n
+}
+
+// ForceEndGame finishes the running game of the room with the given scores,
+// the result is saved like any other match
+func (a *AdminServer) ForceEndGame(ctx context.Context, in *pb.ForceEndGameRequest) (*pb.AdminReply, error) {
+	if len(in.Scores) != 0 && len(in.Scores) != 4 {
+		return nil, errBadScores
+	}
+	r, err := a.s.getRoom(in.RoomID)
+	if err != nil {
+		return nil, err
+	}
+	if r.State() != room.StatePlaying {
+		return nil, room.ErrWrongState
+	}
+	result := &room.MatchResult{StartedAt: r.StartedAt()}
+	for seat := range result.Scores {
+		result.Scores[seat] = r.Session.Scoring.StartPoints
+		if len(in.Scores) != 0 {
+			result.Scores[seat] = int(in.Scores[seat])
+		}
+	}
+	msg := fmt.Sprintf("game of room: %s, ended by admin: %s", r.RoomName, in.Reason)
+	_ = a.s.startBoardCast(r, &pb.StartReply{Message: msg})
+	if err = a.s.FinishMatch(r.RoomID, result); err != nil {
+		return nil, err
+	}
+	roomLog(logging.FromContext(ctx), r).WithFields(log.Fields{
+		logging.EventKey: "AdminForceEnd",
+		"Reason":         in.Reason,
+	}).Info("game ended by admin")
+	return &pb.AdminReply{Message: msg}, nil
+}
+
+func (a *AdminServer) SetLogLevel(ctx context.Context, in *pb.SetLogLevelRequest) (*pb.SetLogLevelReply, error) {
+	level, err := log.ParseLevel(in.Level)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if in.RoomID == nil {
+		previous := logging.SetLevel(level)
+		logging.FromContext(ctx).WithFields(log.Fields{
+			logging.EventKey: "AdminSetLogLevel",
+			"Previous":       previous.String(),
+			"Level":          level.String(),
+		}).Warning("log level changed")
+		return &pb.SetLogLevelReply{
+			Message:  fmt.Sprintf("log level set to %s", level),
+			Previous: previous.String(),
+		}, nil
+	}
+	r, err := a.s.getRoom(in.GetRoomID())
+	if err != nil {
+		return nil, err
+	}
+	previous := logging.SetRoomLevel(r.RoomID.String(), level)
+	roomLog(logging.FromContext(ctx), r).WithFields(log.Fields{
+		logging.EventKey: "AdminSetLogLevel",
+		"Previous":       previous.String(),
+		"Level":          level.String(),
+	}).Warning("room log level changed")
+	return &pb.SetLogLevelReply{
+		Message:  fmt.Sprintf("log level of room: %s, set to %s", r.RoomName, level),
+		Previous: previous.String(),
+	}, nil
+}
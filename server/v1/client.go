@@ -1,20 +1,30 @@
 package v1
 
 import (
+	"errors"
 	"github.com/google/uuid"
 	"github.com/hphphp123321/mahjong-goserver/player"
 	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 type client struct {
+	// mu is held while the ready stream is opened and while the player is taken out of its room
+	mu          sync.Mutex
 	readyStream pb.Mahjong_ReadyServer
+	readyMu     sync.Mutex
+	seating     bool // a room is being set up for the player
 	startStream pb.Mahjong_StartServer
+	startMu     sync.Mutex
 
-	lastTime time.Time
+	lastTime atomic.Int64 // UnixNano of the last call, RPCs of a session run concurrently
 	online   bool
 
-	done chan error
+	done   chan error
+	doneMu sync.Mutex
+	closed bool
 
 	p *player.Player
 	//playerName string
@@ -22,23 +32,136 @@ type client struct {
 }
 
 func newClient(playerName string, token uuid.UUID) *client {
-	return &client{
-		p:        player.NewPlayer(playerName, token),
-		lastTime: time.Now(),
-		done:     make(chan error),
+	c := &client{
+		p:    player.NewPlayer(playerName, token),
+		done: make(chan error, 1),
+	}
+	c.touch()
+	return c
+}
+
+// touch records a call of the client
+func (c *client) touch() {
+	c.lastTime.Store(time.Now().UnixNano())
+}
+
+// lastSeen returns the time of the last call of the client
+func (c *client) lastSeen() time.Time {
+	return time.Unix(0, c.lastTime.Load())
+}
+
+// getReadyStream returns the ready stream, nil if the player has none open
+func (c *client) getReadyStream() pb.Mahjong_ReadyServer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.readyStream
+}
+
+// claimSeat marks the player as being seated, it fails if the player is in a room
+// or another room is being set up for it so the player never ends up in two rooms
+func (c *client) claimSeat() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.p.RoomID != uuid.Nil || c.seating {
+		return false
+	}
+	c.seating = true
+	return true
+}
+
+// releaseSeat ends a claim, the player entered the room or stays out of any
+func (c *client) releaseSeat() {
+	c.mu.Lock()
+	c.seating = false
+	c.mu.Unlock()
+}
+
+// enterRoom records the room the player was seated in
+func (c *client) enterRoom(roomID uuid.UUID) {
+	c.mu.Lock()
+	c.p.RoomID = roomID
+	c.mu.Unlock()
+}
+
+// leaveRoom resets the room state of the player and ends the ready stream,
+// the ready handler returns so the player can open a new one in the next room
+func (c *client) leaveRoom() {
+	c.mu.Lock()
+	hadStream := c.readyStream != nil
+	c.readyStream = nil
+	c.p.RoomID = uuid.Nil
+	c.p.SetReady(false)
+	c.mu.Unlock()
+	if hadStream {
+		c.finish(nil)
+	}
+}
+
+// finish ends the ready stream of the client with the error, it never blocks
+// and does nothing once the client is closed
+func (c *client) finish(err error) {
+	c.doneMu.Lock()
+	defer c.doneMu.Unlock()
+	if c.closed {
+		return
+	}
+	select {
+	case c.done <- err:
+	default:
+	}
+}
+
+// close ends the ready stream for good, the client is logged out
+func (c *client) close() {
+	c.doneMu.Lock()
+	defer c.doneMu.Unlock()
+	if !c.closed {
+		c.closed = true
+		close(c.done)
 	}
 }
 
 // sendReadyMessage send message to client in ready stage
 func (c *client) sendReadyMessage(msg string) error {
-	var err error
-	rep := &pb.ReadyReply{
+	err := c.sendReadyReply(&pb.ReadyReply{
 		Message: msg,
+	})
+	if errors.Is(err, errNoReadyStream) {
+		// the player was taken out of the room meanwhile
+		return err
 	}
-	err = c.readyStream.Send(rep)
 	if err != nil {
-		c.done <- err
+		c.finish(err)
 		return err
 	}
 	return nil
 }
+
+// sendReadyReply sends on the ready stream, replies come from the stream handler,
+// from broadcasts of the room and from admins so sends are serialized
+func (c *client) sendReadyReply(rep *pb.ReadyReply) error {
+	rs := c.getReadyStream()
+	if rs == nil {
+		return errNoReadyStream
+	}
+	c.readyMu.Lock()
+	defer c.readyMu.Unlock()
+	return rs.Send(rep)
+}
+
+// sendStartReply sends on the start stream, replies come from the stream handler
+// and from broadcasts of other players so sends are serialized
+func (c *client) sendStartReply(rep *pb.StartReply) error {
+	c.startMu.Lock()
+	defer c.startMu.Unlock()
+	if c.startStream == nil {
+		return errNoStartStream
+	}
+	return c.startStream.Send(rep)
+}
+
+func (c *client) hasStartStream() bool {
+	c.startMu.Lock()
+	defer c.startMu.Unlock()
+	return c.startStream != nil
+}
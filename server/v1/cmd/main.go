@@ -1,138 +1,221 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
 	"flag"
 	"fmt"
+	"github.com/hphphp123321/mahjong-goserver/auth"
+	"github.com/hphphp123321/mahjong-goserver/config"
+	"github.com/hphphp123321/mahjong-goserver/gateway"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	"github.com/hphphp123321/mahjong-goserver/matchmaking"
+	"github.com/hphphp123321/mahjong-goserver/metrics"
+	"github.com/hphphp123321/mahjong-goserver/osutils"
+	"github.com/hphphp123321/mahjong-goserver/rating"
+	"github.com/hphphp123321/mahjong-goserver/robots"
 	v1 "github.com/hphphp123321/mahjong-goserver/server/v1"
 	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	"github.com/hphphp123321/mahjong-goserver/storage"
+	"github.com/hphphp123321/mahjong-goserver/tlsutil"
+	"github.com/hphphp123321/mahjong-goserver/tracing"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 	"net"
+	"net/http"
 	"os"
-	"path"
-	"runtime"
-	"strconv"
 	"time"
 )
 
-var (
-	maxClients int
-	address    string
-	port       int
-
-	minTime               int
-	maxConnectionIdle     int
-	maxConnectionAgeGrace int
-	timeTick              int
-	timeout               int
-
-	logFormat string
-	logLevel  string
-	logOutput string
-	logFile   string
-)
-
-func parseFlags() {
-	flag.IntVar(&maxClients, "maxClients", 10, "max clients")
-	flag.StringVar(&address, "address", "127.0.0.1", "server address")
-	flag.IntVar(&port, "port", 16548, "port")
-
-	flag.IntVar(&minTime, "minTime", 1, "If a client pings more than once every MinTime seconds, terminate the connection")
-	flag.IntVar(&maxConnectionIdle, "maxConnectionIdle", 15, "If a client is idle for Idle seconds, send a GOAWAY")
-	flag.IntVar(&maxConnectionAgeGrace, "maxConnectionAgeGrace", 5, "Allow Grace seconds for pending RPCs to complete before forcibly closing connections")
-	flag.IntVar(&timeTick, "timeTick", 10, "Ping the client if it is idle for timeTick seconds to ensure the connection is still active")
-	flag.IntVar(&timeout, "timeout", 5, "Wait 1 second for the ping ack before assuming the connection is dead")
-
-	flag.StringVar(&logFormat, "logFormat", "text", "log format(json or text)")
-	flag.StringVar(&logLevel, "logLevel", "debug", "log level(debug, info, warn, error, fatal, panic)")
-	flag.StringVar(&logOutput, "logOutput", "stdout", "log output(stdout or stderr)")
-	flag.StringVar(&logFile, "logFile", "", "log file path")
-	flag.Parse()
-}
-
-func setupLogger() {
-	switch logFormat {
-	case "text":
-		log.SetFormatter(&log.TextFormatter{
-			ForceColors:               true,
-			TimestampFormat:           "2006-01-02 15:04:05",
-			FullTimestamp:             true,
-			EnvironmentOverrideColors: true,
-			CallerPrettyfier: func(frame *runtime.Frame) (function string, file string) {
-				//处理文件名
-				fileName := path.Base(frame.File)
-				return ": " + strconv.Itoa(frame.Line), fileName
-			},
-		})
-	case "json":
-		log.SetFormatter(&log.JSONFormatter{})
-	default:
-		log.Error("set log format error")
+func main() {
+	cfg := config.DefaultServer()
+	if err := config.Load(os.Args[0], os.Args[1:], cfg); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
 	}
-
-	switch logLevel {
-	case "debug":
-		log.SetLevel(log.DebugLevel)
-		log.SetReportCaller(true)
-	case "info":
-		log.SetLevel(log.InfoLevel)
-	case "warn":
-		log.SetLevel(log.WarnLevel)
-	case "error":
-		log.SetLevel(log.ErrorLevel)
-	case "fatal":
-		log.SetLevel(log.FatalLevel)
-	default:
-		log.Error("set log level error")
+	if err := cfg.Log.Setup(); err != nil {
+		log.Fatalf("failed to set up logging: %v", err)
 	}
-
-	switch logOutput {
-	case "stdout":
-		log.SetOutput(os.Stdout)
-	case "stderr":
-		log.SetOutput(os.Stderr)
-	default:
-		log.Error("set log output error")
+	log.Debug("Hello World!")
+	shutdownTracing, err := tracing.Setup(tracing.Options{
+		ServiceName: "mahjong-goserver",
+		Exporter:    cfg.Tracing.Exporter,
+		File:        cfg.Tracing.File,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		log.Fatalf("failed to set up tracing: %v", err)
 	}
-
-	if logFile != "" {
-		f, err := os.OpenFile(logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
-		if err == nil {
-			log.SetOutput(f)
-		} else {
-			log.Error("set log file error")
+	for _, r := range cfg.Robots {
+		if err := robots.Alias(r.Name, r.Use); err != nil {
+			log.Fatalf("failed to register robot: %v", err)
 		}
 	}
-
-}
-
-func main() {
-	parseFlags()
-	setupLogger()
-	log.Debug("Hello World!")
-	tcpAddr := fmt.Sprintf("%s:%d", address, port)
+	robots.SetDecisionTimeout(time.Duration(cfg.Limits.RobotDecisionTimeout) * time.Second)
+	if _, err := robots.GetRobot(cfg.Queue.RobotLevel); err != nil {
+		log.Fatalf("queue.robotLevel %s: %v", cfg.Queue.RobotLevel, err)
+	}
+	tcpAddr := cfg.Addr()
 	log.Debug("Start listening at ", tcpAddr)
 	lis, err := net.Listen("tcp", tcpAddr)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 	var kasp = keepalive.ServerParameters{
-		MaxConnectionIdle:     time.Duration(maxConnectionIdle) * time.Second,
-		MaxConnectionAgeGrace: time.Duration(maxConnectionAgeGrace) * time.Second,
-		Time:                  time.Duration(timeTick) * time.Second,
-		Timeout:               time.Duration(timeout) * time.Second,
+		MaxConnectionIdle:     time.Duration(cfg.Keepalive.MaxConnectionIdle) * time.Second,
+		MaxConnectionAgeGrace: time.Duration(cfg.Keepalive.MaxConnectionAgeGrace) * time.Second,
+		Time:                  time.Duration(cfg.Keepalive.Time) * time.Second,
+		Timeout:               time.Duration(cfg.Keepalive.Timeout) * time.Second,
 	}
 
 	var kaep = keepalive.EnforcementPolicy{
-		MinTime:             time.Duration(minTime) * time.Second,
+		MinTime:             time.Duration(cfg.Keepalive.MinTime) * time.Second,
 		PermitWithoutStream: true,
 	}
-	s := grpc.NewServer(grpc.KeepaliveEnforcementPolicy(kaep), grpc.KeepaliveParams(kasp))
-	server := v1.NewMahjongServer(10)
+	store, err := storage.OpenBoltStore(cfg.Storage.DBPath)
+	if err != nil {
+		log.Fatalf("failed to open database: %v", err)
+	}
+	defer store.Close()
+	var users auth.UserStore = store
+	if cfg.Storage.UserFile != "" {
+		users, err = auth.NewFileUserStore(cfg.Storage.UserFile)
+		if err != nil {
+			log.Fatalf("failed to open user file: %v", err)
+		}
+	}
+	secret := []byte(cfg.Auth.TokenSecret)
+	if len(secret) == 0 && cfg.Auth.TokenSecretFile != "" {
+		secret, err = auth.LoadOrCreateSecret(cfg.Auth.TokenSecretFile)
+		if err != nil {
+			log.Fatalf("failed to load token secret: %v", err)
+		}
+	}
+	signer, err := auth.NewSigner(secret, time.Duration(cfg.Auth.TokenTTL)*time.Minute)
+	if err != nil {
+		log.Fatalf("failed to create token signer: %v", err)
+	}
+	ratings, err := rating.New(cfg.Rating)
+	if err != nil {
+		log.Fatalf("failed to create rating system %s: %v", cfg.Rating, err)
+	}
+	server := v1.NewMahjongServer(cfg.Limits.MaxClients, users, signer, store, ratings)
+	server.SetQueueOptions(matchmaking.Options{
+		WidenEvery: time.Duration(cfg.Queue.Widen) * time.Second,
+		RobotAfter: time.Duration(cfg.Queue.RobotAfter) * time.Second,
+		RobotLevel: cfg.Queue.RobotLevel,
+	})
+	server.SetRuleSets(cfg.RoomRuleSets())
+	server.SetAdminToken(cfg.Auth.AdminToken)
+	server.SetMinProtocol(cfg.MinProtocol())
+	if cfg.Storage.SnapshotFile != "" {
+		if err = server.RestoreSnapshot(cfg.Storage.SnapshotFile); err != nil {
+			log.Fatalf("failed to restore snapshot: %v", err)
+		}
+	}
+	// the gateway runs its calls through the same interceptors
+	unaryInterceptors := []grpc.UnaryServerInterceptor{tracing.UnaryServerInterceptor, logging.UnaryServerInterceptor, server.UnaryMetricsInterceptor, server.UnaryAuthInterceptor, v1.UnaryValidateInterceptor}
+	streamInterceptors := []grpc.StreamServerInterceptor{tracing.StreamServerInterceptor, logging.StreamServerInterceptor, server.StreamMetricsInterceptor, server.StreamAuthInterceptor}
+	opts := []grpc.ServerOption{
+		grpc.KeepaliveEnforcementPolicy(kaep),
+		grpc.KeepaliveParams(kasp),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+	var tlsConfig *tls.Config
+	if cfg.TLS.Enabled() {
+		tlsConfig, err = tlsutil.ServerConfig(cfg.TLS.CertFile, cfg.TLS.KeyFile, cfg.TLS.ClientCAFile, cfg.TLS.RequireClientCert)
+		if err != nil {
+			log.Fatalf("failed to set up TLS: %v", err)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+		log.WithFields(log.Fields{
+			"ClientCA":          cfg.TLS.ClientCAFile,
+			"RequireClientCert": cfg.TLS.RequireClientCert,
+		}).Info("serving TLS")
+	}
+	s := grpc.NewServer(opts...)
 	pb.RegisterMahjongServer(s, server)
+	pb.RegisterMahjongAdminServer(s, v1.NewAdminServer(server))
 
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			log.Fatalf("failed to serve: %v", err)
+		}
+	}()
+
+	var metricsServer *http.Server
+	if cfg.Metrics.Address != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler(server.Metrics(), metrics.Default))
+		metricsServer = &http.Server{Addr: cfg.Metrics.Address, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+		go func() {
+			log.Debug("Serving metrics at ", cfg.Metrics.Address)
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatalf("failed to serve metrics: %v", err)
+			}
+		}()
+	}
+
+	var gatewayServer *http.Server
+	if cfg.Gateway.Address != "" {
+		gw := gateway.New(server, gateway.Options{
+			Unary:          unaryInterceptors,
+			Stream:         streamInterceptors,
+			AllowedOrigins: cfg.Gateway.AllowedOrigins,
+		})
+		gatewayServer = &http.Server{Addr: cfg.Gateway.Address, Handler: gw, TLSConfig: tlsConfig, ReadHeaderTimeout: 5 * time.Second}
+		go func() {
+			log.Debug("Serving gateway at ", cfg.Gateway.Address)
+			var err error
+			if tlsConfig != nil {
+				err = gatewayServer.ListenAndServeTLS("", "")
+			} else {
+				err = gatewayServer.ListenAndServe()
+			}
+			if err != nil && err != http.ErrServerClosed {
+				log.Fatalf("failed to serve gateway: %v", err)
+			}
+		}()
+	}
+
+	sig := <-osutils.NewShutdownSignal()
+	log.Infof("receive exit signal %s, shutting down", sig)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.Shutdown.DrainTimeout)*time.Second)
+	defer cancel()
+	if err := server.Shutdown(ctx, cfg.Shutdown.MaintenanceNotice); err != nil {
+		log.Errorf("failed to drain server: %v", err)
+	}
+	if cfg.Storage.SnapshotFile != "" {
+		if err := server.WriteSnapshot(cfg.Storage.SnapshotFile); err != nil {
+			log.Errorf("failed to write snapshot: %v", err)
+		}
+	}
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Duration(cfg.Shutdown.StopTimeout) * time.Second):
+		log.Warning("streams still open, stopping server")
+		s.Stop()
+	}
+	if gatewayServer != nil {
+		_ = gatewayServer.Close()
+	}
+	if metricsServer != nil {
+		_ = metricsServer.Close()
+	}
+	if err := shutdownTracing(context.Background()); err != nil {
+		log.Errorf("failed to flush traces: %v", err)
 	}
+	log.Info("server stopped")
 }
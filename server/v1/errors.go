@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"github.com/hphphp123321/mahjong-goserver/auth"
+	"github.com/hphphp123321/mahjong-goserver/matchmaking"
+	"github.com/hphphp123321/mahjong-goserver/protocol"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	"github.com/hphphp123321/mahjong-goserver/storage"
+	"github.com/hphphp123321/mahjong-goserver/tournament"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errNoToken         = status.Error(codes.Unauthenticated, "no token in metadata")
+	errInvalidToken    = status.Error(codes.Unauthenticated, "invalid token")
+	errTooManyClients  = status.Error(codes.ResourceExhausted, "too many clients")
+	errAlreadyInRoom   = status.Error(codes.FailedPrecondition, "already in room")
+	errNotInRoom       = status.Error(codes.FailedPrecondition, "not in room")
+	errRoomNotFound    = status.Error(codes.NotFound, "room not found")
+	errHasReadyStream  = status.Error(codes.AlreadyExists, "already has ready stream")
+	errNoReadyStream   = status.Error(codes.FailedPrecondition, "don't have ready stream")
+	errHasStartStream  = status.Error(codes.AlreadyExists, "already has start stream")
+	errNoStartStream   = status.Error(codes.FailedPrecondition, "don't have start stream")
+	errReceiveFailed   = status.Error(codes.Unavailable, "failed to receive request")
+	errMissingPassword = status.Error(codes.InvalidArgument, "player name and password are required")
+	errBadPageToken    = status.Error(codes.InvalidArgument, "invalid page token")
+	errInQueue         = status.Error(codes.FailedPrecondition, "in matchmaking queue")
+	errTooManyAttempts = status.Error(codes.ResourceExhausted, "too many wrong passwords, try again later")
+	errNotAdmin        = status.Error(codes.PermissionDenied, "admin only")
+	errNoAdminToken    = status.Error(codes.Unauthenticated, "no admin token in metadata")
+	errAdminDisabled   = status.Error(codes.PermissionDenied, "admin service is disabled")
+	errBannedPlayer    = status.Error(codes.PermissionDenied, "banned from this server")
+	errClientNotFound  = status.Error(codes.NotFound, "player is not online")
+	errShuttingDown    = status.Error(codes.Unavailable, "server is shutting down")
+	errTablePlaying    = status.Error(codes.FailedPrecondition, "a table of the round already started")
+	errLobbyBehind     = status.Error(codes.ResourceExhausted, "too far behind the lobby, watch again")
+	errBadScores       = status.Error(codes.InvalidArgument, "scores need one value per seat or none")
+)
+
+// toStatus maps errors from other packages to grpc status errors,
+// so clients can branch on the status code instead of the message
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	case errors.Is(err, auth.ErrUserNotFound), errors.Is(err, room.ErrPlayerNotFound), errors.Is(err, room.ErrSeatEmpty),
+		errors.Is(err, storage.ErrProfileNotFound), errors.Is(err, storage.ErrSessionNotFound),
+		errors.Is(err, tournament.ErrNotFound), errors.Is(err, tournament.ErrTableNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, auth.ErrUserExists), errors.Is(err, matchmaking.ErrAlreadyQueued), errors.Is(err, tournament.ErrRegistered):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, auth.ErrWrongPassword), errors.Is(err, auth.ErrInvalidToken), errors.Is(err, auth.ErrTokenExpired):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, room.ErrWrongPassword), errors.Is(err, room.ErrPasswordRequired),
+		errors.Is(err, room.ErrPrivateRoom), errors.Is(err, room.ErrBanned), errors.Is(err, room.ErrNotOwner):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, room.ErrInvalidScoring), errors.Is(err, room.ErrUnknownRuleSet), errors.Is(err, protocol.ErrBadVersion),
+		errors.Is(err, tournament.ErrInvalidRounds), errors.Is(err, tournament.ErrUnknownPairing):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, room.ErrRoomFull), errors.Is(err, room.ErrSeatUsed), errors.Is(err, matchmaking.ErrNotQueued),
+		errors.Is(err, room.ErrWrongState), errors.Is(err, room.ErrInvalidTransition),
+		errors.Is(err, tournament.ErrNotRegistered), errors.Is(err, tournament.ErrWrongStatus),
+		errors.Is(err, tournament.ErrTooFewPlayers), errors.Is(err, tournament.ErrRoundStarted),
+		errors.Is(err, protocol.ErrIncompatible):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -5,17 +5,24 @@ import (
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/hphphp123321/mahjong-goserver/auth"
 	"github.com/hphphp123321/mahjong-goserver/common"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	"github.com/hphphp123321/mahjong-goserver/matchmaking"
 	"github.com/hphphp123321/mahjong-goserver/player"
+	"github.com/hphphp123321/mahjong-goserver/protocol"
+	"github.com/hphphp123321/mahjong-goserver/rating"
 	"github.com/hphphp123321/mahjong-goserver/robots"
 	_ "github.com/hphphp123321/mahjong-goserver/robots/simple"
 	"github.com/hphphp123321/mahjong-goserver/room"
 	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	"github.com/hphphp123321/mahjong-goserver/storage"
+	"github.com/hphphp123321/mahjong-goserver/tournament"
 	log "github.com/sirupsen/logrus"
-	"google.golang.org/grpc/metadata"
 	"io"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -27,66 +34,170 @@ type MahjongServer struct {
 
 	rooms  map[uuid.UUID]*room.Room
 	roomMu sync.RWMutex
+	lobby  *lobby
+
+	users  auth.UserStore
+	signer *auth.Signer
+	store  storage.Store
+	rating rating.System
+	queue  *matchmaking.Queue
+
+	roomAttempts *attemptLimiter
+
+	ruleSets  map[string]room.RuleSet
+	ruleSetMu sync.RWMutex
+
+	tournaments  map[uuid.UUID]*tournament.Tournament
+	tournamentMu sync.RWMutex
+
+	adminToken string
+	banned     map[string]string // player name to reason
+	adminMu    sync.RWMutex
+
+	minProtocol protocol.Version
+	protocolMu  sync.RWMutex
+
+	draining atomic.Bool
+	stop     chan struct{}
+
+	metrics *serverMetrics
 }
 
-func NewMahjongServer(maxClients int) *MahjongServer {
-	return &MahjongServer{
+func NewMahjongServer(maxClients int, users auth.UserStore, signer *auth.Signer, store storage.Store, ratingSystem rating.System) *MahjongServer {
+	s := &MahjongServer{
 		clients:    make(map[uuid.UUID]*client),
 		rooms:      make(map[uuid.UUID]*room.Room),
+		lobby:      newLobby(),
 		maxClients: maxClients,
+		users:      users,
+		signer:     signer,
+		store:      store,
+		rating:     ratingSystem,
+		queue:      matchmaking.NewQueue(ratingSystem.Step(), matchmaking.DefaultOptions()),
+
+		roomAttempts: newAttemptLimiter(maxPasswordAttempts, passwordAttemptWindow),
+
+		tournaments: make(map[uuid.UUID]*tournament.Tournament),
+		banned:      make(map[string]string),
+		stop:        make(chan struct{}),
+
+		minProtocol: protocol.Matrix[0].Version,
 	}
+	s.metrics = newServerMetrics(s)
+	go s.runMatchmaking()
+	return s
 }
 
 func (s *MahjongServer) Ping(ctx context.Context, in *pb.Empty) (*pb.Empty, error) {
 	return &pb.Empty{}, nil
 }
 
+func (s *MahjongServer) Register(ctx context.Context, in *pb.RegisterRequest) (*pb.RegisterReply, error) {
+	hash, err := auth.HashPassword(in.Password)
+	if err != nil {
+		return nil, err
+	}
+	err = s.users.CreateUser(&auth.User{
+		Name:         in.PlayerName,
+		PasswordHash: hash,
+		CreatedAt:    time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey:      "Register",
+		logging.PlayerNameKey: in.PlayerName,
+	}).Info("player register success")
+	return &pb.RegisterReply{
+		Message: "register success",
+	}, nil
+}
+
 func (s *MahjongServer) Login(ctx context.Context, in *pb.LoginRequest) (*pb.LoginReply, error) {
+	if s.isBanned(in.PlayerName) {
+		return nil, errBannedPlayer
+	}
+	u, err := s.users.GetUser(in.PlayerName)
+	if err != nil {
+		return nil, err
+	}
+	if err = auth.CheckPassword(u, in.Password); err != nil {
+		return nil, err
+	}
+	profile, err := s.store.TouchProfile(in.PlayerName, time.Now())
+	if err != nil {
+		return nil, err
+	}
 	s.clientMu.Lock()
 	defer s.clientMu.Unlock()
 	for t, p := range s.clients {
 		if p.p.PlayerName == in.PlayerName {
 			p.online = true
+			token, claims, err := s.signer.Sign(t, in.PlayerName)
+			if err != nil {
+				return nil, err
+			}
 			return &pb.LoginReply{
-				Message: "login success",
-				Token:   t.String(),
+				Message:   "login success",
+				Token:     token,
+				ExpiresAt: claims.ExpiresAt,
 			}, nil
 		}
 	}
 	if len(s.clients) >= s.maxClients {
-		return nil, errors.New("too many clients")
-	}
-	token := uuid.New()
-	s.clients[token] = newClient(in.PlayerName, token)
-	log.WithFields(log.Fields{
-		"Event":      "Login",
-		"PlayerName": in.PlayerName,
-		"UUID":       token.String(),
+		return nil, errTooManyClients
+	}
+	session := uuid.New()
+	token, claims, err := s.signer.Sign(session, in.PlayerName)
+	if err != nil {
+		return nil, err
+	}
+	c := newClient(in.PlayerName, session)
+	c.p.Rank = s.rating.Rank(profile.Rating(s.rating))
+	s.clients[session] = c
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey:      "Login",
+		logging.PlayerNameKey: in.PlayerName,
+		logging.SessionKey:    session.String(),
 	}).Info("player login success")
 	return &pb.LoginReply{
-		Message: "login success",
-		Token:   token.String(),
+		Message:   "login success",
+		Token:     token,
+		ExpiresAt: claims.ExpiresAt,
+	}, nil
+}
+
+func (s *MahjongServer) RefreshToken(ctx context.Context, in *pb.Empty) (*pb.RefreshTokenReply, error) {
+	c, err := clientFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, claims, err := s.signer.Sign(c.p.Token, c.p.PlayerName)
+	if err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey: "RefreshToken",
+	}).Debug("refresh token success")
+	return &pb.RefreshTokenReply{
+		Message:   "refresh token success",
+		Token:     token,
+		ExpiresAt: claims.ExpiresAt,
 	}, nil
 }
 
 func (s *MahjongServer) Logout(ctx context.Context, in *pb.Empty) (*pb.LogoutReply, error) {
-	c, err := s.getClient(ctx)
+	c, err := clientFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if c.p.RoomID != uuid.Nil {
-		err = s.LeaveRoom(c)
-		if err != nil {
-			return nil, err
-		}
+	if err = s.endSession(c); err != nil {
+		return nil, err
 	}
-	c.readyStream = nil
-	close(c.done)
-	s.removeClient(c)
-	log.WithFields(log.Fields{
-		"Event":      "Logout",
-		"PlayerName": c.p.PlayerName,
-		"UUID":       c.p.Token.String(),
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey:   "Logout",
+		logging.SessionKey: c.p.Token.String(),
 	}).Info("player logout success")
 	return &pb.LogoutReply{
 		Message: "logout success",
@@ -94,27 +205,23 @@ func (s *MahjongServer) Logout(ctx context.Context, in *pb.Empty) (*pb.LogoutRep
 }
 
 func (s *MahjongServer) RefreshRoom(ctx context.Context, in *pb.RefreshRoomRequest) (*pb.RefreshRoomReply, error) {
-	_, err := s.getClient(ctx)
-	if err != nil {
-		return nil, err
-	}
 	roomSlice := make([]*pb.Room, 0)
 	rName := ""
 	if in.RoomName != nil {
 		rName = *in.RoomName
 	}
-	for id, r := range s.rooms {
+	s.roomMu.RLock()
+	for _, r := range s.rooms {
+		if r.Private || r.State() == room.StateClosed {
+			continue
+		}
 		if strings.Contains(r.RoomName, rName) {
-			roomSlice = append(roomSlice, &pb.Room{
-				RoomID:      id.String(),
-				RoomName:    r.RoomName,
-				PlayerCount: int32(r.PlayerCount),
-				OwnerName:   r.Owner.PlayerName,
-			})
+			roomSlice = append(roomSlice, newPbRoom(r))
 		}
 	}
-	log.WithFields(log.Fields{
-		"Event": "RefreshRoom",
+	s.roomMu.RUnlock()
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey: "RefreshRoom",
 	}).Debug("refresh room success")
 	return &pb.RefreshRoomReply{
 		Message: "refresh room success, room count: " + fmt.Sprint(len(roomSlice)),
@@ -123,65 +230,127 @@ func (s *MahjongServer) RefreshRoom(ctx context.Context, in *pb.RefreshRoomReque
 }
 
 func (s *MahjongServer) CreateRoom(ctx context.Context, in *pb.CreateRoomRequest) (*pb.CreateRoomReply, error) {
-	c, err := s.getClient(ctx)
+	c, err := clientFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if c.p.RoomID != uuid.Nil {
-		return nil, errors.New("already in room")
+	if s.queue.Contains(c.p.Token) {
+		return nil, errInQueue
 	}
-	s.clientMu.Lock()
-	defer s.clientMu.Unlock()
+	if !c.claimSeat() {
+		return nil, errAlreadyInRoom
+	}
+	defer c.releaseSeat()
 	roomId := uuid.New()
+	preset, err := s.ruleSetPreset(in.GetRuleSet())
+	if err != nil {
+		return nil, err
+	}
 	newRoom := room.NewRoom(roomId, in.RoomName, c.p)
+	if in.RuleSet != nil && *in.RuleSet != "" {
+		newRoom.RuleSet = *in.RuleSet
+	}
+	if preset != nil {
+		preset.Apply(newRoom)
+	}
+	newRoom.Private = in.Private
+	if in.SeatDraw != pb.SeatDrawMode_NoDraw {
+		newRoom.SeatDraw = room.SeatDraw(in.SeatDraw)
+	}
+	newRoom.SeatDrawSeed = in.SeatDrawSeed
+	if in.RematchSeating != nil {
+		newRoom.RematchSeating = room.SeatDraw(*in.RematchSeating)
+	}
+	newRoom.ReplaceLeavers = in.ReplaceLeavers
+	if in.Scoring != nil {
+		if newRoom.Session.Scoring, err = room.NewScoring(int(in.Scoring.StartPoints), int(in.Scoring.ReturnPoints), in.Scoring.Uma); err != nil {
+			return nil, err
+		}
+	}
+	// hashing is slow, it runs before the lock
+	if err = newRoom.SetPassword(in.GetPassword()); err != nil {
+		return nil, err
+	}
+	s.clientMu.Lock()
+	defer s.clientMu.Unlock()
+	if newRoom.InviteCode, err = s.newInviteCode(); err != nil {
+		return nil, err
+	}
 	err = newRoom.AddPlayer(c.p)
 	if err != nil {
 		return nil, err
 	}
+	s.roomMu.Lock()
 	s.rooms[roomId] = newRoom
-	c.p.RoomID = newRoom.RoomID
+	s.roomMu.Unlock()
+	s.lobby.add(newRoom)
+	c.enterRoom(newRoom.RoomID)
 
-	log.WithFields(log.Fields{
-		"Event":      "CreateRoom",
-		"PlayerName": c.p.PlayerName,
-		"RoomName":   in.RoomName,
-		"UUID":       roomId.String(),
+	roomLog(logging.FromContext(ctx), newRoom).WithFields(log.Fields{
+		logging.EventKey: "CreateRoom",
+		"Private":        newRoom.Private,
 	}).Info("create room success")
 	return &pb.CreateRoomReply{
-		Message: fmt.Sprintf("Create Room Success! Room UUID: %s", roomId.String()),
-		Room: &pb.Room{
-			RoomID:      roomId.String(),
-			RoomName:    in.RoomName,
-			PlayerCount: 1,
-			OwnerName:   c.p.PlayerName,
-		}}, nil
+		Message:    fmt.Sprintf("Create Room Success! Room UUID: %s", roomId.String()),
+		Room:       newPbRoom(newRoom),
+		InviteCode: newRoom.InviteCode,
+	}, nil
 }
 
 func (s *MahjongServer) JoinRoom(ctx context.Context, in *pb.JoinRoomRequest) (*pb.JoinRoomReply, error) {
-	c, err := s.getClient(ctx)
+	c, err := clientFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if c.p.RoomID != uuid.Nil {
-		return nil, errors.New("already in room")
+	if s.queue.Contains(c.p.Token) {
+		return nil, errInQueue
 	}
-	s.clientMu.Lock()
-	defer s.clientMu.Unlock()
-	roomId, err := uuid.Parse(in.RoomID)
-	if err != nil {
-		return nil, err
+	if !c.claimSeat() {
+		return nil, errAlreadyInRoom
 	}
-	joinRoom, ok := s.rooms[roomId]
-	if !ok {
-		return nil, errors.New("room not found")
-	}
-	if joinRoom.IsFull() {
-		return nil, errors.New("room is full")
+	defer c.releaseSeat()
+	inviteCode := strings.ToUpper(strings.TrimSpace(in.GetInviteCode()))
+	var joinRoom *room.Room
+	if in.RoomID == "" {
+		guessKey := attemptKey{playerName: c.p.PlayerName}
+		if !s.roomAttempts.Allow(guessKey) {
+			return nil, errTooManyAttempts
+		}
+		joinRoom = s.getRoomByInviteCode(inviteCode)
+		if joinRoom == nil {
+			// unknown codes count as failures so codes can't be guessed
+			s.roomAttempts.Fail(guessKey)
+		}
+	} else {
+		roomId, err := uuid.Parse(in.RoomID)
+		if err != nil {
+			return nil, err
+		}
+		s.roomMu.RLock()
+		joinRoom = s.rooms[roomId]
+		s.roomMu.RUnlock()
+	}
+	if joinRoom == nil {
+		return nil, errRoomNotFound
+	}
+	roomId := joinRoom.RoomID
+	key := attemptKey{playerName: c.p.PlayerName, roomID: roomId}
+	if !s.roomAttempts.Allow(key) {
+		return nil, errTooManyAttempts
+	}
+	if err = joinRoom.CheckAccess(inviteCode, in.GetPassword()); err != nil {
+		if errors.Is(err, room.ErrWrongPassword) {
+			s.roomAttempts.Fail(key)
+			roomLog(logging.FromContext(ctx), joinRoom).WithFields(log.Fields{
+				logging.EventKey: "JoinRoom",
+			}).Warning("wrong room password")
+		}
+		return nil, err
 	}
-	if err = joinRoom.AddPlayer(c.p); err != nil {
+	s.roomAttempts.Reset(key)
+	if err = s.seatPlayer(joinRoom, c); err != nil {
 		return nil, err
 	}
-	c.p.RoomID = joinRoom.RoomID
 	seat, err := joinRoom.GetSeat(c.p)
 	if err != nil {
 		return nil, err
@@ -192,6 +361,7 @@ func (s *MahjongServer) JoinRoom(ctx context.Context, in *pb.JoinRoomRequest) (*
 		Reply: &pb.ReadyReply_PlayerJoin{PlayerJoin: &pb.PlayerJoinReply{
 			Seat:       int32(seat),
 			PlayerName: c.p.PlayerName,
+			Rank:       c.p.Rank,
 		}},
 	}
 	err = s.readyBoardCast(c, rep, false)
@@ -199,49 +369,77 @@ func (s *MahjongServer) JoinRoom(ctx context.Context, in *pb.JoinRoomRequest) (*
 		return nil, err
 	}
 
-	log.WithFields(log.Fields{
-		"Event":      "JoinRoom",
-		"PlayerName": c.p.PlayerName,
-		"RoomName":   joinRoom.RoomName,
-		"Seat":       seat,
+	roomLog(logging.FromContext(ctx), joinRoom).WithFields(log.Fields{
+		logging.EventKey: "JoinRoom",
+		logging.SeatKey:  seat,
 	}).Info("join room success")
 	return &pb.JoinRoomReply{
-		Message: fmt.Sprintf("Join Room Success! Room UUID: %s", roomId.String()),
-		Seat:    int32(seat),
-		Room: &pb.Room{
-			RoomID:      roomId.String(),
-			RoomName:    joinRoom.RoomName,
-			PlayerCount: int32(joinRoom.PlayerCount),
-			OwnerName:   joinRoom.Owner.PlayerName,
-		}}, nil
+		Message:    fmt.Sprintf("Join Room Success! Room UUID: %s", roomId.String()),
+		Seat:       int32(seat),
+		Room:       newPbRoom(joinRoom),
+		InviteCode: joinRoom.InviteCode,
+	}, nil
+}
+
+// seatPlayer adds the player to the room, players are seated one at a time
+func (s *MahjongServer) seatPlayer(r *room.Room, c *client) error {
+	s.clientMu.Lock()
+	defer s.clientMu.Unlock()
+	if r.IsFull() {
+		return room.ErrRoomFull
+	}
+	if err := r.AddPlayer(c.p); err != nil {
+		return err
+	}
+	c.enterRoom(r.RoomID)
+	return nil
 }
 
 func (s *MahjongServer) Ready(stream pb.Mahjong_ReadyServer) error {
 	ctx := stream.Context()
-	c, err := s.getClient(ctx)
+	c, err := clientFromContext(ctx)
 	if err != nil {
 		return err
 	}
+	c.mu.Lock()
 	if c.p.RoomID == uuid.Nil {
-		return errors.New("not in room")
+		c.mu.Unlock()
+		return errNotInRoom
 	}
 	if c.readyStream != nil {
-		return errors.New("already has ready stream")
+		c.mu.Unlock()
+		return errHasReadyStream
 	}
 	c.readyStream = stream
-	log.Infof("Start new ReadyStream for player: %s", c.p.PlayerName)
+	// a previous ready stream may have left its result behind
+	select {
+	case <-c.done:
+	default:
+	}
+	c.mu.Unlock()
+	c.log().Info("ReadyStream started")
 	go func() {
 		for {
 			in, err := stream.Recv()
 			if err == io.EOF {
-				c.done <- nil
+				c.finish(nil)
+				return
+			}
+			if err != nil && ctx.Err() != nil {
+				// the handler returned, the player left the room or went away
 				return
 			}
 			if err != nil {
-				log.Warningf("receive error %v", err)
-				c.done <- errors.New("failed to receive request")
+				c.log().Warningf("ReadyStream receive failed: %v", err)
+				c.finish(errReceiveFailed)
 				return
 			}
+			if msg, ok := s.checkRoomState(c, in, readyRequestStates(in)); !ok {
+				if err = c.sendReadyMessage(msg); err != nil {
+					return
+				}
+				continue
+			}
 			switch in.GetRequest().(type) {
 			case *pb.ReadyRequest_GetReady:
 				err = s.handleGetReadyRequest(c, in)
@@ -254,10 +452,9 @@ func (s *MahjongServer) Ready(stream pb.Mahjong_ReadyServer) error {
 					return
 				}
 			case *pb.ReadyRequest_LeaveRoom:
-				err = s.handleLeaveRoomRequest(c, in)
-				if err != nil {
-					return
-				}
+				// the stream ends with the room either way
+				_ = s.handleLeaveRoomRequest(c, in)
+				return
 			case *pb.ReadyRequest_RemovePlayer:
 				err = s.handleRemovePlayerRequest(c, in)
 				if err != nil {
@@ -268,6 +465,36 @@ func (s *MahjongServer) Ready(stream pb.Mahjong_ReadyServer) error {
 				if err != nil {
 					return
 				}
+			case *pb.ReadyRequest_SwapSeats:
+				err = s.handleSwapSeatsRequest(c, in)
+				if err != nil {
+					return
+				}
+			case *pb.ReadyRequest_MoveSeat:
+				err = s.handleMoveSeatRequest(c, in)
+				if err != nil {
+					return
+				}
+			case *pb.ReadyRequest_KickPlayer:
+				err = s.handleKickPlayerRequest(c, in)
+				if err != nil {
+					return
+				}
+			case *pb.ReadyRequest_TransferOwner:
+				err = s.handleTransferOwnerRequest(c, in)
+				if err != nil {
+					return
+				}
+			case *pb.ReadyRequest_StartGame:
+				err = s.handleStartGameRequest(c, in)
+				if err != nil {
+					return
+				}
+			case *pb.ReadyRequest_Rematch:
+				err = s.handleRematchRequest(c, in)
+				if err != nil {
+					return
+				}
 			case *pb.ReadyRequest_Chat:
 				rep := &pb.ReadyReply{
 					Message: fmt.Sprintf("player: %s, send chat message", c.p.PlayerName),
@@ -278,7 +505,7 @@ func (s *MahjongServer) Ready(stream pb.Mahjong_ReadyServer) error {
 				}
 				err = s.readyBoardCast(c, rep, true)
 				if err != nil {
-					c.done <- err
+					c.finish(err)
 					return
 				}
 			}
@@ -289,7 +516,7 @@ func (s *MahjongServer) Ready(stream pb.Mahjong_ReadyServer) error {
 	case <-ctx.Done():
 		doneError = ctx.Err()
 	case <-c.done:
-		log.Info("ReadyStream done for player: ", c.p.PlayerName)
+		c.log().Info("ReadyStream done")
 	}
 	if doneError != nil {
 		return doneError
@@ -297,9 +524,51 @@ func (s *MahjongServer) Ready(stream pb.Mahjong_ReadyServer) error {
 	return nil
 }
 
+func newPbRoom(r *room.Room) *pb.Room {
+	return &pb.Room{
+		RoomID:      r.RoomID.String(),
+		RoomName:    r.RoomName,
+		PlayerCount: int32(r.PlayerCount),
+		OwnerName:   r.Owner.PlayerName,
+		RuleSet:     r.RuleSet,
+		OwnerRank:   r.Owner.Rank,
+		Private:     r.Private,
+		HasPassword: r.HasPassword(),
+		SeatDraw:    pb.SeatDrawMode(r.SeatDraw),
+		Status:      newPbRoomStatus(r.State()),
+	}
+}
+
+// newInviteCode returns an invite code no other room uses
+func (s *MahjongServer) newInviteCode() (string, error) {
+	for {
+		code, err := room.NewInviteCode()
+		if err != nil {
+			return "", err
+		}
+		if s.getRoomByInviteCode(code) == nil {
+			return code, nil
+		}
+	}
+}
+
+func (s *MahjongServer) getRoomByInviteCode(code string) *room.Room {
+	if code == "" {
+		return nil
+	}
+	s.roomMu.RLock()
+	defer s.roomMu.RUnlock()
+	for _, r := range s.rooms {
+		if r.InviteCode == code {
+			return r
+		}
+	}
+	return nil
+}
+
 func (s *MahjongServer) readyBoardCast(c *client, resp *pb.ReadyReply, includeSelf bool) error {
 	if c.p.RoomID == uuid.Nil {
-		return errors.New("not in room")
+		return errNotInRoom
 	}
 	r, err := s.getRoomByClient(c)
 	if err != nil {
@@ -311,42 +580,50 @@ func (s *MahjongServer) readyBoardCast(c *client, resp *pb.ReadyReply, includeSe
 				continue
 			}
 		}
-		if s.clients[p.Token].readyStream == nil {
-			return errors.New("don't have ready stream")
+		if p.IsRobot() {
+			continue
+		}
+		s.clientMu.RLock()
+		pc, ok := s.clients[p.Token]
+		s.clientMu.RUnlock()
+		if !ok {
+			continue
+		}
+		err = pc.sendReadyReply(resp)
+		if errors.Is(err, errNoReadyStream) {
+			// players seated by matchmaking open their ready stream after the room exists
+			if pc == c {
+				return err
+			}
+			continue
 		}
-		if err := s.clients[p.Token].readyStream.Send(resp); err != nil {
+		if err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (s *MahjongServer) getToken(ctx context.Context) (uuid.UUID, error) {
-	headers, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return uuid.UUID{}, errors.New("no metadata in context")
-	}
-	token, err := uuid.Parse(headers["token"][0])
-	_, ok = s.clients[token]
-	if err != nil || !ok {
-		return uuid.UUID{}, errors.New("invalid token")
-	}
-	return token, nil
-}
-
-func (s *MahjongServer) getClient(ctx context.Context) (*client, error) {
-	s.clientMu.RLock()
-	defer s.clientMu.RUnlock()
-	token, err := s.getToken(ctx)
-	if err != nil {
-		return nil, err
-	}
-	c, ok := s.clients[token]
-	c.lastTime = time.Now()
-	if !ok {
-		return nil, errors.New("invalid token")
+// endSession takes the client out of its room and the queue and removes it
+func (s *MahjongServer) endSession(c *client) error {
+	if c.p.RoomID != uuid.Nil {
+		err := s.LeaveRoom(c)
+		if errors.Is(err, room.ErrWrongState) {
+			// the seat stays taken until the running game ends
+			c.log().WithFields(log.Fields{
+				logging.EventKey: "Logout",
+			}).Warning("logout during a running game")
+		} else if err != nil {
+			return err
+		}
 	}
-	return c, nil
+	_ = s.queue.Leave(c.p.Token)
+	c.mu.Lock()
+	c.readyStream = nil
+	c.mu.Unlock()
+	c.close()
+	s.removeClient(c)
+	return nil
 }
 
 func (s *MahjongServer) removeClient(c *client) {
@@ -359,11 +636,11 @@ func (s *MahjongServer) getRoomByClient(c *client) (*room.Room, error) {
 	s.roomMu.RLock()
 	defer s.roomMu.RUnlock()
 	if c.p.RoomID == uuid.Nil {
-		return nil, errors.New("not in room")
+		return nil, errNotInRoom
 	}
 	cRoom, ok := s.rooms[c.p.RoomID]
 	if !ok {
-		return nil, errors.New("room not exist")
+		return nil, errRoomNotFound
 	}
 	return cRoom, nil
 }
@@ -377,17 +654,34 @@ func (s *MahjongServer) LeaveRoom(c *client) error {
 	if r == nil {
 		return nil
 	}
-	log.Debugf("LeaveRoom: PlayerName: %s, RoomName: %s", c.p.PlayerName, r.RoomName)
+	roomLog(c.log(), r).Debug("LeaveRoom")
 
 	roomID := r.RoomID
-	if err := r.RemovePlayer(c.p); err != nil {
+	var robotPlayer *player.Player
+	if r.State() == room.StateFinished && r.ReplaceLeavers {
+		level := s.queue.Options().RobotLevel
+		agent, err := robots.GetRobot(level)
+		if err != nil {
+			return err
+		}
+		robotPlayer = player.NewRobot(level, c.p.Seat, agent)
+		err = r.ReplaceWithRobot(c.p, robotPlayer)
+		if err != nil {
+			return err
+		}
+	} else if err := r.RemovePlayer(c.p); err != nil {
 		return err
 	}
-	if r.IsEmpty() {
+	if r.HumanCount() == 0 {
+		if err := r.Transition(room.StateClosed); err != nil {
+			return err
+		}
 		s.roomMu.Lock()
 		delete(s.rooms, roomID)
 		s.roomMu.Unlock()
-		log.Printf("Room %s is empty, delete", roomID.String())
+		s.lobby.remove(r)
+		roomLog(c.log(), r).Info("room is empty, deleted")
+		logging.ReleaseRoom(roomID)
 	} else {
 		rep := &pb.ReadyReply{Message: fmt.Sprintf("player: %s, leave room", c.p.PlayerName),
 			Reply: &pb.ReadyReply_PlayerLeave{PlayerLeave: &pb.PlayerLeaveReply{
@@ -398,22 +692,31 @@ func (s *MahjongServer) LeaveRoom(c *client) error {
 		if err := s.readyBoardCast(c, rep, true); err != nil {
 			return err
 		}
+		if robotPlayer != nil {
+			rep = &pb.ReadyReply{
+				Message: fmt.Sprintf("robot: %s, take the seat of player: %s", robotPlayer.PlayerName, c.p.PlayerName),
+				Reply: &pb.ReadyReply_AddRobot{AddRobot: &pb.AddRobotReply{
+					RobotSeat:  int32(robotPlayer.Seat),
+					RobotLevel: robotPlayer.PlayerName,
+				}},
+			}
+			if err := s.readyBoardCast(c, rep, true); err != nil {
+				return err
+			}
+		}
 	}
-	log.WithFields(log.Fields{
-		"PlayerName": c.p.PlayerName,
-		"RoomName":   r.RoomName,
-	}).Debug("LeaveRoom success")
+	roomLog(c.log(), r).Debug("LeaveRoom success")
 	r = nil
-	c.readyStream = nil
+	c.leaveRoom()
 
 	return nil
 }
 
 func (s *MahjongServer) handleGetReadyRequest(c *client, in *pb.ReadyRequest) error {
 	var err error
-	log.Debugf("GetReady Req: PlayerName: %s,  request: %s", c.p.PlayerName, in.GetGetReady().String())
+	c.log().Debugf("GetReady Req: request: %s", in.GetGetReady().String())
 	if c.p.Ready {
-		log.Warning("Player already ready")
+		c.log().Warning("player already ready")
 		return nil
 	}
 	c.p.SetReady(true)
@@ -427,20 +730,19 @@ func (s *MahjongServer) handleGetReadyRequest(c *client, in *pb.ReadyRequest) er
 	}
 	err = s.readyBoardCast(c, rep, true)
 	if err != nil {
-		c.done <- err
+		c.finish(err)
 		return err
 	}
-	log.WithFields(log.Fields{
-		"Event":      "GetReady",
-		"PlayerName": c.p.PlayerName,
-		"Seat":       c.p.Seat,
+	c.log().WithFields(log.Fields{
+		logging.EventKey: "GetReady",
+		logging.SeatKey:  c.p.Seat,
 	}).Info("Player Get Ready Success")
 	return nil
 }
 
 func (s *MahjongServer) handleCancelReadyRequest(c *client, in *pb.ReadyRequest) error {
 	var err error
-	log.Debugf("CancelReady Req: PlayerName: %s,  request: %s", c.p.PlayerName, in.GetCancelReady().String())
+	c.log().Debugf("CancelReady Req: request: %s", in.GetCancelReady().String())
 	c.p.SetReady(false)
 
 	rep := &pb.ReadyReply{
@@ -452,29 +754,27 @@ func (s *MahjongServer) handleCancelReadyRequest(c *client, in *pb.ReadyRequest)
 	}
 	err = s.readyBoardCast(c, rep, true)
 	if err != nil {
-		c.done <- err
+		c.finish(err)
 		return err
 	}
-	log.WithFields(log.Fields{
-		"Event":      "CancelReady",
-		"PlayerName": c.p.PlayerName,
-		"Seat":       c.p.Seat,
+	c.log().WithFields(log.Fields{
+		logging.EventKey: "CancelReady",
+		logging.SeatKey:  c.p.Seat,
 	}).Info("Player Cancel Ready success")
 	return nil
 }
 
 func (s *MahjongServer) handleLeaveRoomRequest(c *client, in *pb.ReadyRequest) error {
 	var err error
-	log.Debugf("LeaveRoom Req: PlayerName: %s,  request: %s", c.p.PlayerName, in.GetLeaveRoom().String())
+	c.log().Debugf("LeaveRoom Req: request: %s", in.GetLeaveRoom().String())
 	err = s.LeaveRoom(c)
 	if err != nil {
-		c.done <- err
+		c.finish(err)
 		return err
 	}
-	log.WithFields(log.Fields{
-		"Event":      "LeaveRoom",
-		"PlayerName": c.p.PlayerName,
-		"Seat":       c.p.Seat,
+	c.log().WithFields(log.Fields{
+		logging.EventKey: "LeaveRoom",
+		logging.SeatKey:  c.p.Seat,
 	}).Info("Player Leave Room success")
 	return nil
 }
@@ -485,28 +785,11 @@ func (s *MahjongServer) handleRemovePlayerRequest(c *client, in *pb.ReadyRequest
 	if err != nil {
 		return err
 	}
-	if r.Owner != c.p {
-		err = c.sendReadyMessage(fmt.Sprintf("player: %s, is not owner, can't remove player", c.p.PlayerName))
-		if err != nil {
-			return err
-		}
-	}
-	log.Debugf("RemovePlayer Req: PlayerName: %s, RoomName: %s, request: %s", c.p.PlayerName, r.RoomName, in.GetRemovePlayer().String())
+	roomLog(c.log(), r).Debugf("RemovePlayer Req: request: %s", in.GetRemovePlayer().String())
 	seat := int(in.GetRemovePlayer().PlayerSeat)
-	playerToRemove, err := r.GetPlayerBySeat(seat)
+	playerToRemove, err := r.Kick(c.p, seat, false)
 	if err != nil {
-		return err
-	}
-	if playerToRemove == c.p {
-		err = c.sendReadyMessage(fmt.Sprintf("player: %s, can't remove self", c.p.PlayerName))
-		if err != nil {
-			return err
-		}
-	}
-	err = r.RemovePlayer(playerToRemove)
-	if err != nil {
-		c.done <- err
-		return err
+		return c.sendReadyMessage(fmt.Sprintf("player: %s, can't remove player: %v", c.p.PlayerName, err))
 	}
 	rep := &pb.ReadyReply{
 		Message: fmt.Sprintf("player: %s, remove player: %s success", c.p.PlayerName, playerToRemove.PlayerName),
@@ -516,17 +799,16 @@ func (s *MahjongServer) handleRemovePlayerRequest(c *client, in *pb.ReadyRequest
 			OwnerSeat:  int32(r.Owner.Seat),
 		}},
 	}
+	s.detachPlayer(playerToRemove.Token, rep)
 	err = s.readyBoardCast(c, rep, true)
 	if err != nil {
-		c.done <- err
+		c.finish(err)
 		return err
 	}
-	log.WithFields(log.Fields{
-		"Event":             "RemovePlayer",
-		"PlayerName":        c.p.PlayerName,
+	roomLog(c.log(), r).WithFields(log.Fields{
+		logging.EventKey:    "RemovePlayer",
 		"RemovedPlayerName": playerToRemove.PlayerName,
-		"RoomName":          r.RoomName,
-		"Seat":              seat,
+		logging.SeatKey:     seat,
 	}).Info("Player Remove Player success")
 	return nil
 }
@@ -538,34 +820,22 @@ func (s *MahjongServer) handleAddRobotRequest(c *client, in *pb.ReadyRequest) er
 		return err
 	}
 	if r.Owner != c.p {
-		err = c.sendReadyMessage(fmt.Sprintf("player: %s, is not owner, can't add robot", c.p.PlayerName))
-		if err != nil {
-			return err
-		}
+		return c.sendReadyMessage(fmt.Sprintf("player: %s, is not owner, can't add robot", c.p.PlayerName))
 	}
-	log.Debugf("AddRobot Req: PlayerName: %s, RoomName: %s, request: %s", c.p.PlayerName, r.RoomName, in.GetAddRobot().String())
+	roomLog(c.log(), r).Debugf("AddRobot Req: request: %s", in.GetAddRobot().String())
 	seat := int(in.GetAddRobot().RobotSeat)
 	if !common.Contain(seat, r.IdleSeats) {
-		err = c.sendReadyMessage(fmt.Sprintf("seat %v not valid", seat))
-		if err != nil {
-			return err
-		}
+		return c.sendReadyMessage(fmt.Sprintf("seat %v not valid", seat))
 	}
 	level := in.GetAddRobot().RobotLevel
 	robot, err := robots.GetRobot(level)
 	if err != nil {
-		err = c.sendReadyMessage(fmt.Sprintf("robot level %s not valid", level))
-		if err != nil {
-			return err
-		}
+		return c.sendReadyMessage(fmt.Sprintf("robot level %s not valid", level))
 	}
 	robotPlayer := player.NewRobot(level, seat, robot)
 	err = r.AddRobot(robotPlayer)
 	if err != nil {
-		err = c.sendReadyMessage(err.Error())
-		if err != nil {
-			return err
-		}
+		return c.sendReadyMessage(err.Error())
 	}
 	rep := &pb.ReadyReply{
 		Message: fmt.Sprintf("player: %s, add robot: %s success", c.p.PlayerName, robotPlayer.PlayerName),
@@ -575,15 +845,13 @@ func (s *MahjongServer) handleAddRobotRequest(c *client, in *pb.ReadyRequest) er
 		}},
 	}
 	err = s.readyBoardCast(c, rep, true)
-	log.WithFields(log.Fields{
-		"Event":      "AddRobot",
-		"PlayerName": c.p.PlayerName,
-		"RoomName":   r.RoomName,
-		"RobotLevel": level,
-		"Seat":       seat,
+	roomLog(c.log(), r).WithFields(log.Fields{
+		logging.EventKey: "AddRobot",
+		"RobotLevel":     level,
+		logging.SeatKey:  seat,
 	}).Info("Player Add Robot success")
 	if err != nil {
-		c.done <- err
+		c.finish(err)
 		return err
 	}
 	return nil
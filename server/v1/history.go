@@ -0,0 +1,204 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	"github.com/hphphp123321/mahjong-goserver/rating"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	"github.com/hphphp123321/mahjong-goserver/storage"
+	"github.com/hphphp123321/mahjong-goserver/tracing"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"sort"
+	"time"
+)
+
+// FinishMatch is called by the game engine when a match of the room ends,
+// the result is persisted and the aggregates and ratings of every human player are updated.
+// The room is finished once everything is saved, it keeps playing if saving fails.
+// A tournament room is closed afterwards and its result goes to the tournament
+func (s *MahjongServer) FinishMatch(roomID uuid.UUID, result *room.MatchResult) (err error) {
+	_, span := tracing.StartTurn(context.Background(), tracing.SpanGameFinish, tracing.RoomTurn(roomID))
+	defer func() {
+		tracing.End(span, err)
+	}()
+	s.roomMu.RLock()
+	r, ok := s.rooms[roomID]
+	s.roomMu.RUnlock()
+	if !ok {
+		return errRoomNotFound
+	}
+	if err := r.CheckState(room.StatePlaying); err != nil {
+		return err
+	}
+	placements := result.Placements()
+	matchID := r.MatchID()
+	if matchID == uuid.Nil {
+		matchID = uuid.New()
+	}
+	m := &storage.Match{
+		MatchID:    matchID,
+		RoomID:     r.RoomID,
+		RoomName:   r.RoomName,
+		RuleSet:    r.RuleSet,
+		StartedAt:  result.StartedAt,
+		FinishedAt: time.Now(),
+		ReplayRef:  result.ReplayRef,
+
+		RatingSystem: s.rating.Name(),
+	}
+	for _, p := range r.Players {
+		mp := storage.MatchPlayer{
+			PlayerName:   p.PlayerName,
+			Seat:         p.Seat,
+			Robot:        p.IsRobot(),
+			Score:        result.Scores[p.Seat],
+			Placement:    placements[p.Seat],
+			HandStats:    result.Stats[p.Seat],
+			RatingBefore: s.rating.Initial(),
+		}
+		if !mp.Robot {
+			profile, err := s.store.GetProfile(p.PlayerName)
+			if err != nil {
+				return err
+			}
+			mp.RatingBefore = profile.Rating(s.rating)
+		}
+		m.Players = append(m.Players, mp)
+	}
+	sort.Slice(m.Players, func(i, j int) bool {
+		return m.Players[i].Seat < m.Players[j].Seat
+	})
+	states := make([]rating.State, 0, len(m.Players))
+	matchPlacements := make([]int, 0, len(m.Players))
+	for _, mp := range m.Players {
+		states = append(states, mp.RatingBefore)
+		matchPlacements = append(matchPlacements, mp.Placement)
+	}
+	for i, state := range s.rating.Update(states, matchPlacements) {
+		m.Players[i].RatingAfter = state
+	}
+	if err := s.store.SaveMatch(m); err != nil {
+		return err
+	}
+	s.metrics.matchesFinished.Inc()
+	hands := 0
+	for _, stats := range result.Stats {
+		// every seat plays every hand, a robot seat may report none
+		if stats.Hands > hands {
+			hands = stats.Hands
+		}
+	}
+	s.metrics.handsPlayed.Add(float64(hands))
+	span.SetAttributes(attribute.Int("mahjong.hands", hands))
+	sm := room.SessionMatch{MatchID: m.MatchID, FinishedAt: m.FinishedAt}
+	for _, mp := range m.Players {
+		name := mp.PlayerName
+		if mp.Robot {
+			// robots share their level as name
+			name = fmt.Sprintf("%s (seat %d)", name, mp.Seat)
+		}
+		sm.Results = append(sm.Results, room.SessionResult{
+			PlayerName: name,
+			Seat:       mp.Seat,
+			Robot:      mp.Robot,
+			Score:      mp.Score,
+			Placement:  mp.Placement,
+		})
+	}
+	session := r.RecordMatch(sm)
+	if err := s.store.SaveSession(session); err != nil {
+		return err
+	}
+	if err := r.Transition(room.StateFinished); err != nil {
+		return err
+	}
+	for _, mp := range m.Players {
+		if p, err := r.GetPlayerBySeat(mp.Seat); err == nil && !p.IsRobot() {
+			p.Rank = s.rating.Rank(mp.RatingAfter)
+			p.SetReady(false)
+		}
+	}
+	roomLog(serverLog(), r).WithFields(log.Fields{
+		logging.EventKey:   "FinishMatch",
+		logging.MatchIDKey: m.MatchID.String(),
+		logging.HandsKey:   hands,
+	}).Info("match result saved")
+	s.recordTournamentTable(r, session.Matches[len(session.Matches)-1].Results)
+	return nil
+}
+
+func (s *MahjongServer) GetProfile(ctx context.Context, in *pb.Empty) (*pb.GetProfileReply, error) {
+	c, err := clientFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.store.GetProfile(c.p.PlayerName)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetProfileReply{
+		Message: "get profile success",
+		Profile: newPbProfile(p),
+	}, nil
+}
+
+func (s *MahjongServer) GetMatchHistory(ctx context.Context, in *pb.GetMatchHistoryRequest) (*pb.GetMatchHistoryReply, error) {
+	c, err := clientFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := s.store.ListMatches(c.p.PlayerName, int(in.Limit))
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*pb.MatchRecord, 0, len(matches))
+	for _, m := range matches {
+		records = append(records, newPbMatchRecord(m))
+	}
+	return &pb.GetMatchHistoryReply{
+		Message: fmt.Sprintf("get match history success, match count: %d", len(records)),
+		Matches: records,
+	}, nil
+}
+
+func newPbProfile(p *storage.Profile) *pb.PlayerProfile {
+	placements := make([]int32, 0, len(p.Aggregate.Placements))
+	for _, n := range p.Aggregate.Placements {
+		placements = append(placements, int32(n))
+	}
+	return &pb.PlayerProfile{
+		PlayerName:       p.Name,
+		CreatedAt:        p.CreatedAt.Unix(),
+		LastLoginAt:      p.LastLoginAt.Unix(),
+		MatchCount:       int32(p.Aggregate.Matches),
+		TotalScore:       int64(p.Aggregate.TotalScore),
+		Placements:       placements,
+		AveragePlacement: p.Aggregate.AveragePlacement(),
+	}
+}
+
+func newPbMatchRecord(m *storage.Match) *pb.MatchRecord {
+	players := make([]*pb.MatchPlayerResult, 0, len(m.Players))
+	for _, p := range m.Players {
+		players = append(players, &pb.MatchPlayerResult{
+			PlayerName: p.PlayerName,
+			Seat:       int32(p.Seat),
+			Robot:      p.Robot,
+			Score:      int32(p.Score),
+			Placement:  int32(p.Placement),
+		})
+	}
+	return &pb.MatchRecord{
+		MatchID:    m.MatchID.String(),
+		RoomName:   m.RoomName,
+		RuleSet:    m.RuleSet,
+		StartedAt:  m.StartedAt.Unix(),
+		FinishedAt: m.FinishedAt.Unix(),
+		Players:    players,
+		ReplayRef:  m.ReplayRef,
+	}
+}
@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"context"
+	"github.com/hphphp123321/mahjong-goserver/auth"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	"github.com/hphphp123321/mahjong-goserver/tracing"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// publicMethods can be called without a token
+var publicMethods = map[string]bool{
+	"/mahjong.Mahjong/Ping":     true,
+	"/mahjong.Mahjong/Hello":    true,
+	"/mahjong.Mahjong/Register": true,
+	"/mahjong.Mahjong/Login":    true,
+}
+
+// helloMethod checks the protocol version itself, to answer with the compatibility matrix
+const helloMethod = "/mahjong.Mahjong/Hello"
+
+// drainRefusedMethods are refused once the server is shutting down,
+// they would start something that can't finish before the server stops
+var drainRefusedMethods = map[string]bool{
+	"/mahjong.Mahjong/Register":             true,
+	"/mahjong.Mahjong/Login":                true,
+	"/mahjong.Mahjong/CreateRoom":           true,
+	"/mahjong.Mahjong/JoinQueue":            true,
+	"/mahjong.MahjongAdmin/StartTournament": true,
+}
+
+type clientKey struct{}
+
+// clientFromContext returns the client put into the context by the auth interceptors
+func clientFromContext(ctx context.Context) (*client, error) {
+	c, ok := ctx.Value(clientKey{}).(*client)
+	if !ok {
+		return nil, errInvalidToken
+	}
+	return c, nil
+}
+
+// authenticate verifies the token in the request metadata and puts the client of its session into the context
+func (s *MahjongServer) authenticate(ctx context.Context) (context.Context, error) {
+	headers, _ := metadata.FromIncomingContext(ctx)
+	tokens := headers.Get("token")
+	if len(tokens) == 0 {
+		return nil, errNoToken
+	}
+	claims, err := s.signer.Verify(tokens[0])
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.sessionClient(claims)
+	if err != nil {
+		return nil, err
+	}
+	tracing.SetPlayer(ctx, c.p.PlayerName)
+	ctx = logging.WithFields(ctx, log.Fields{logging.PlayerNameKey: c.p.PlayerName})
+	return context.WithValue(ctx, clientKey{}, c), nil
+}
+
+func (s *MahjongServer) sessionClient(claims *auth.Claims) (*client, error) {
+	s.clientMu.RLock()
+	defer s.clientMu.RUnlock()
+	c, ok := s.clients[claims.Session]
+	if !ok {
+		return nil, errInvalidToken
+	}
+	c.touch()
+	return c, nil
+}
+
+func (s *MahjongServer) UnaryAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if s.draining.Load() && drainRefusedMethods[info.FullMethod] {
+		return nil, errShuttingDown
+	}
+	if !isAdminMethod(info.FullMethod) && info.FullMethod != helloMethod {
+		if err := s.checkProtocol(ctx); err != nil {
+			return nil, toStatus(err)
+		}
+	}
+	if isAdminMethod(info.FullMethod) {
+		if err := s.authenticateAdmin(ctx); err != nil {
+			return nil, err
+		}
+	} else if !publicMethods[info.FullMethod] {
+		var err error
+		ctx, err = s.authenticate(ctx)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+	}
+	resp, err := handler(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *MahjongServer) StreamAuthInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if s.draining.Load() && drainRefusedMethods[info.FullMethod] {
+		return errShuttingDown
+	}
+	if !isAdminMethod(info.FullMethod) {
+		if err := s.checkProtocol(ss.Context()); err != nil {
+			return toStatus(err)
+		}
+	}
+	if isAdminMethod(info.FullMethod) {
+		if err := s.authenticateAdmin(ss.Context()); err != nil {
+			return err
+		}
+	} else if !publicMethods[info.FullMethod] {
+		ctx, err := s.authenticate(ss.Context())
+		if err != nil {
+			return toStatus(err)
+		}
+		ss = &authedStream{ServerStream: ss, ctx: ctx}
+	}
+	return toStatus(handler(srv, ss))
+}
+
+// authedStream overrides the stream context with the authenticated one
+type authedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *authedStream) Context() context.Context {
+	return w.ctx
+}
+
+// UnaryValidateInterceptor rejects malformed requests before they reach the handlers
+func UnaryValidateInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
@@ -0,0 +1,178 @@
+package v1
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	"github.com/hphphp123321/mahjong-goserver/storage"
+	"sort"
+	"time"
+)
+
+const (
+	defaultLeaderboardPageSize = 20
+	maxLeaderboardPageSize     = 100
+)
+
+// leaderboardRow is the aggregate of one player over the requested window
+type leaderboardRow struct {
+	name       string
+	rank       string
+	rating     float64
+	matches    int
+	placements int
+	totalScore int64
+	lastMatch  time.Time
+}
+
+func (r *leaderboardRow) averagePlacement() float64 {
+	if r.matches == 0 {
+		return 0
+	}
+	return float64(r.placements) / float64(r.matches)
+}
+
+// leaderboardCursor is the sort key of the last entry of a page,
+// the next page starts right after it so new matches don't shift pages
+type leaderboardCursor struct {
+	Value float64 `json:"v"`
+	Name  string  `json:"n"`
+}
+
+func (s *MahjongServer) GetLeaderboard(ctx context.Context, in *pb.GetLeaderboardRequest) (*pb.GetLeaderboardReply, error) {
+	var since time.Time
+	switch in.Window {
+	case pb.TimeWindow_Week:
+		since = time.Now().AddDate(0, 0, -7)
+	case pb.TimeWindow_Month:
+		since = time.Now().AddDate(0, 0, -30)
+	}
+	matches, err := s.store.MatchesSince(since)
+	if err != nil {
+		return nil, err
+	}
+	rows := s.leaderboardRows(matches, in.RuleSet)
+
+	value := leaderboardValue(in.OrderBy)
+	filtered := rows[:0]
+	for _, r := range rows {
+		if r.matches >= int(in.MinMatches) {
+			filtered = append(filtered, r)
+		}
+	}
+	rows = filtered
+	less := func(a *leaderboardRow, b leaderboardCursor) bool {
+		va := value(a)
+		if va != b.Value {
+			if in.OrderBy == pb.LeaderboardOrder_ByAveragePlacement {
+				return va < b.Value
+			}
+			return va > b.Value
+		}
+		return a.name < b.Name
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return less(rows[i], leaderboardCursor{Value: value(rows[j]), Name: rows[j].name})
+	})
+
+	start := 0
+	if in.PageToken != "" {
+		cursor, err := decodeLeaderboardCursor(in.PageToken)
+		if err != nil {
+			return nil, errBadPageToken
+		}
+		start = sort.Search(len(rows), func(i int) bool {
+			return !less(rows[i], cursor) && !(value(rows[i]) == cursor.Value && rows[i].name == cursor.Name)
+		})
+	}
+	size := int(in.PageSize)
+	if size <= 0 {
+		size = defaultLeaderboardPageSize
+	}
+	if size > maxLeaderboardPageSize {
+		size = maxLeaderboardPageSize
+	}
+	end := start + size
+	if end > len(rows) {
+		end = len(rows)
+	}
+
+	reply := &pb.GetLeaderboardReply{Message: "get leaderboard success"}
+	for i := start; i < end; i++ {
+		r := rows[i]
+		reply.Entries = append(reply.Entries, &pb.LeaderboardEntry{
+			Position:         int32(i + 1),
+			PlayerName:       r.name,
+			Rank:             r.rank,
+			Rating:           r.rating,
+			MatchCount:       int32(r.matches),
+			AveragePlacement: r.averagePlacement(),
+			TotalScore:       r.totalScore,
+		})
+	}
+	if end < len(rows) {
+		last := rows[end-1]
+		reply.NextPageToken = encodeLeaderboardCursor(leaderboardCursor{Value: value(last), Name: last.name})
+	}
+	return reply, nil
+}
+
+// leaderboardRows aggregates the human players of the matches, oldest match first,
+// the rating of a player is the one after their latest match in the window
+func (s *MahjongServer) leaderboardRows(matches []*storage.Match, ruleSet *string) []*leaderboardRow {
+	byName := make(map[string]*leaderboardRow)
+	rows := make([]*leaderboardRow, 0)
+	for _, m := range matches {
+		if ruleSet != nil && *ruleSet != "" && m.RuleSet != *ruleSet {
+			continue
+		}
+		for _, mp := range m.Players {
+			if mp.Robot {
+				continue
+			}
+			r, ok := byName[mp.PlayerName]
+			if !ok {
+				initial := s.rating.Initial()
+				r = &leaderboardRow{name: mp.PlayerName, rating: s.rating.Value(initial), rank: s.rating.Rank(initial)}
+				byName[mp.PlayerName] = r
+				rows = append(rows, r)
+			}
+			r.matches++
+			r.placements += mp.Placement
+			r.totalScore += int64(mp.Score)
+			if m.RatingSystem == s.rating.Name() && !m.FinishedAt.Before(r.lastMatch) {
+				r.lastMatch = m.FinishedAt
+				r.rating = s.rating.Value(mp.RatingAfter)
+				r.rank = s.rating.Rank(mp.RatingAfter)
+			}
+		}
+	}
+	return rows
+}
+
+func leaderboardValue(order pb.LeaderboardOrder) func(r *leaderboardRow) float64 {
+	switch order {
+	case pb.LeaderboardOrder_ByAveragePlacement:
+		return (*leaderboardRow).averagePlacement
+	case pb.LeaderboardOrder_ByTotalPoints:
+		return func(r *leaderboardRow) float64 { return float64(r.totalScore) }
+	default:
+		return func(r *leaderboardRow) float64 { return r.rating }
+	}
+}
+
+func encodeLeaderboardCursor(c leaderboardCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeLeaderboardCursor(token string) (leaderboardCursor, error) {
+	var c leaderboardCursor
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, err
+	}
+	err = json.Unmarshal(data, &c)
+	return c, err
+}
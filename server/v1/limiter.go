@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"github.com/google/uuid"
+	"sync"
+	"time"
+)
+
+const (
+	maxPasswordAttempts   = 5
+	passwordAttemptWindow = time.Minute
+)
+
+// attemptKey is a player trying a room, the key doesn't depend on the session
+// so a new login doesn't start the count over
+type attemptKey struct {
+	playerName string
+	roomID     uuid.UUID // uuid.Nil counts the invite codes that matched no room
+}
+
+// attemptLimiter counts failed attempts of a player on a room and blocks the player from
+// that room for the rest of the window once it has failed too often
+type attemptLimiter struct {
+	max      int
+	window   time.Duration
+	attempts map[attemptKey]*attempts
+	mu       sync.Mutex
+	now      func() time.Time
+}
+
+type attempts struct {
+	count int
+	since time.Time
+}
+
+func newAttemptLimiter(max int, window time.Duration) *attemptLimiter {
+	return &attemptLimiter{
+		max:      max,
+		window:   window,
+		attempts: make(map[attemptKey]*attempts),
+		now:      time.Now,
+	}
+}
+
+func (l *attemptLimiter) Allow(key attemptKey) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	a, ok := l.attempts[key]
+	if !ok {
+		return true
+	}
+	if l.now().Sub(a.since) > l.window {
+		delete(l.attempts, key)
+		return true
+	}
+	return a.count < l.max
+}
+
+func (l *attemptLimiter) Fail(key attemptKey) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	// players that gave up would be kept for ever otherwise
+	for k, a := range l.attempts {
+		if now.Sub(a.since) > l.window {
+			delete(l.attempts, k)
+		}
+	}
+	a, ok := l.attempts[key]
+	if !ok {
+		a = &attempts{since: now}
+		l.attempts[key] = a
+	}
+	a.count++
+}
+
+func (l *attemptLimiter) Reset(key attemptKey) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.attempts, key)
+}
@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"github.com/google/uuid"
+	"testing"
+	"time"
+)
+
+// fakeClock is moved by hand so the window can expire without sleeping
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func newTestLimiter(max int, window time.Duration) (*attemptLimiter, *fakeClock) {
+	clock := &fakeClock{t: time.Unix(1700000000, 0)}
+	l := newAttemptLimiter(max, window)
+	l.now = clock.now
+	return l, clock
+}
+
+func TestAttemptLimiterBlocksAfterMax(t *testing.T) {
+	l, _ := newTestLimiter(3, time.Minute)
+	key := attemptKey{playerName: "a", roomID: uuid.New()}
+	for i := 0; i < 3; i++ {
+		if !l.Allow(key) {
+			t.Fatalf("attempt %d refused before the max", i+1)
+		}
+		l.Fail(key)
+	}
+	if l.Allow(key) {
+		t.Fatal("attempt allowed after the max")
+	}
+}
+
+func TestAttemptLimiterWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		allowed bool
+	}{
+		{"inside the window", 30 * time.Second, false},
+		{"at the end of the window", time.Minute, false},
+		{"after the window", time.Minute + time.Nanosecond, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, clock := newTestLimiter(2, time.Minute)
+			key := attemptKey{playerName: "a", roomID: uuid.New()}
+			l.Fail(key)
+			l.Fail(key)
+			clock.t = clock.t.Add(tt.elapsed)
+			if got := l.Allow(key); got != tt.allowed {
+				t.Errorf("Allow() = %v, want %v", got, tt.allowed)
+			}
+		})
+	}
+}
+
+func TestAttemptLimiterCountRestartsAfterWindow(t *testing.T) {
+	l, clock := newTestLimiter(2, time.Minute)
+	key := attemptKey{playerName: "a", roomID: uuid.New()}
+	l.Fail(key)
+	clock.t = clock.t.Add(2 * time.Minute)
+	l.Fail(key)
+	if !l.Allow(key) {
+		t.Fatal("a failure of an expired window still counts")
+	}
+}
+
+func TestAttemptLimiterKeys(t *testing.T) {
+	l, _ := newTestLimiter(1, time.Minute)
+	room1, room2 := uuid.New(), uuid.New()
+	l.Fail(attemptKey{playerName: "a", roomID: room1})
+
+	tests := []struct {
+		name    string
+		key     attemptKey
+		allowed bool
+	}{
+		{"same player and room", attemptKey{playerName: "a", roomID: room1}, false},
+		{"other room", attemptKey{playerName: "a", roomID: room2}, true},
+		{"other player", attemptKey{playerName: "b", roomID: room1}, true},
+		{"invite code guesses", attemptKey{playerName: "a"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := l.Allow(tt.key); got != tt.allowed {
+				t.Errorf("Allow() = %v, want %v", got, tt.allowed)
+			}
+		})
+	}
+}
+
+func TestAttemptLimiterReset(t *testing.T) {
+	l, _ := newTestLimiter(1, time.Minute)
+	key := attemptKey{playerName: "a", roomID: uuid.New()}
+	l.Fail(key)
+	l.Reset(key)
+	if !l.Allow(key) {
+		t.Fatal("attempt refused after a reset")
+	}
+}
+
+func TestAttemptLimiterPrunesExpired(t *testing.T) {
+	l, clock := newTestLimiter(5, time.Minute)
+	l.Fail(attemptKey{playerName: "a", roomID: uuid.New()})
+	clock.t = clock.t.Add(2 * time.Minute)
+	l.Fail(attemptKey{playerName: "b", roomID: uuid.New()})
+	if len(l.attempts) != 1 {
+		t.Fatalf("got %d entries, want the expired one pruned", len(l.attempts))
+	}
+}
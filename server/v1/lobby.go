@@ -0,0 +1,170 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	log "github.com/sirupsen/logrus"
+	"strings"
+	"sync"
+)
+
+// lobbyBuffer is how many replies a watcher may fall behind before it is dropped
+const lobbyBuffer = 64
+
+// lobby keeps the public rooms as the watchers last saw them and sends them the changes,
+// private rooms are left out like in RefreshRoom
+type lobby struct {
+	mu       sync.Mutex
+	rooms    map[uuid.UUID]*pb.Room
+	watchers map[*lobbyWatcher]bool
+}
+
+type lobbyWatcher struct {
+	roomName string
+	replies  chan *pb.LobbyReply
+	dropped  chan struct{} // closed once the watcher fell behind
+}
+
+func newLobby() *lobby {
+	return &lobby{
+		rooms:    make(map[uuid.UUID]*pb.Room),
+		watchers: make(map[*lobbyWatcher]bool),
+	}
+}
+
+// add tells the watchers about a room put into the server, it is observed from now on
+func (l *lobby) add(r *room.Room) {
+	if r.Private {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	pr := newPbRoom(r)
+	l.rooms[r.RoomID] = pr
+	l.publish(r.RoomName, &pb.LobbyReply{
+		Message: fmt.Sprintf("room: %s, created", r.RoomName),
+		Reply:   &pb.LobbyReply_RoomCreated{RoomCreated: pr},
+	})
+	r.Observe(l.update)
+}
+
+// update is the observer of the rooms, it sends what the watchers care about
+func (l *lobby) update(r *room.Room) {
+	// a room without humans is about to be removed, and has no owner to show
+	if r.State() == room.StateClosed || r.HumanCount() == 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	last, ok := l.rooms[r.RoomID]
+	if !ok {
+		return
+	}
+	pr := newPbRoom(r)
+	l.rooms[r.RoomID] = pr
+	if pr.PlayerCount != last.PlayerCount {
+		l.publish(r.RoomName, &pb.LobbyReply{
+			Message: fmt.Sprintf("room: %s, player count: %d", r.RoomName, pr.PlayerCount),
+			Reply:   &pb.LobbyReply_PlayerCountChanged{PlayerCountChanged: pr},
+		})
+	}
+	if pr.Status != last.Status {
+		l.publish(r.RoomName, &pb.LobbyReply{
+			Message: fmt.Sprintf("room: %s, status: %s", r.RoomName, pr.Status),
+			Reply:   &pb.LobbyReply_StatusChanged{StatusChanged: pr},
+		})
+	}
+}
+
+// remove tells the watchers about a room taken out of the server
+func (l *lobby) remove(r *room.Room) {
+	r.Observe(nil)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.rooms[r.RoomID]; !ok {
+		return
+	}
+	delete(l.rooms, r.RoomID)
+	l.publish(r.RoomName, &pb.LobbyReply{
+		Message: fmt.Sprintf("room: %s, closed", r.RoomName),
+		Reply: &pb.LobbyReply_RoomClosed{RoomClosed: &pb.LobbyRoomClosedReply{
+			RoomID:   r.RoomID.String(),
+			RoomName: r.RoomName,
+		}},
+	})
+}
+
+// publish sends the reply to the watchers of the room name, a watcher that fell behind is dropped
+// so it can watch again from a new snapshot. l has to be locked
+func (l *lobby) publish(roomName string, rep *pb.LobbyReply) {
+	for w := range l.watchers {
+		if !strings.Contains(roomName, w.roomName) {
+			continue
+		}
+		select {
+		case w.replies <- rep:
+		default:
+			delete(l.watchers, w)
+			close(w.dropped)
+		}
+	}
+}
+
+// watch returns a new watcher with the rooms it starts from, no change is missed or sent twice
+func (l *lobby) watch(roomName string) (*lobbyWatcher, []*pb.Room) {
+	w := &lobbyWatcher{
+		roomName: roomName,
+		replies:  make(chan *pb.LobbyReply, lobbyBuffer),
+		dropped:  make(chan struct{}),
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	rooms := make([]*pb.Room, 0)
+	for _, pr := range l.rooms {
+		if strings.Contains(pr.RoomName, roomName) {
+			rooms = append(rooms, pr)
+		}
+	}
+	l.watchers[w] = true
+	return w, rooms
+}
+
+func (l *lobby) unwatch(w *lobbyWatcher) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.watchers, w)
+}
+
+func (s *MahjongServer) WatchLobby(in *pb.WatchLobbyRequest, stream pb.Mahjong_WatchLobbyServer) error {
+	ctx := stream.Context()
+	w, rooms := s.lobby.watch(in.GetRoomName())
+	defer s.lobby.unwatch(w)
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey: "WatchLobby",
+		"Filter":         in.GetRoomName(),
+	}).Debug("player watch lobby")
+	err := stream.Send(&pb.LobbyReply{
+		Message: fmt.Sprintf("lobby snapshot, room count: %d", len(rooms)),
+		Reply:   &pb.LobbyReply_Snapshot{Snapshot: &pb.LobbySnapshotReply{Rooms: rooms}},
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stop:
+			return errShuttingDown
+		case <-w.dropped:
+			return errLobbyBehind
+		case rep := <-w.replies:
+			if err = stream.Send(rep); err != nil {
+				return err
+			}
+		}
+	}
+}
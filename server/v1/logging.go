@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"github.com/google/uuid"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	log "github.com/sirupsen/logrus"
+)
+
+// serverLog is the entry of lines that are not about a request
+func serverLog() *log.Entry {
+	return log.NewEntry(log.StandardLogger())
+}
+
+// log is the entry of lines about the client outside of a request context, like its streams,
+// it carries the room of the client so the lines end up in the room log
+func (c *client) log() *log.Entry {
+	fields := log.Fields{logging.PlayerNameKey: c.p.PlayerName}
+	c.mu.Lock()
+	roomID := c.p.RoomID
+	c.mu.Unlock()
+	if roomID != uuid.Nil {
+		fields[logging.RoomIDKey] = roomID.String()
+	}
+	return log.WithFields(fields)
+}
+
+// roomLog adds the room to the entry, with its match while one is running and the hand once the engine started one
+func roomLog(entry *log.Entry, r *room.Room) *log.Entry {
+	entry = logging.Room(entry, r.RoomID, r.RoomName, r.MatchID())
+	if hand := r.Hand(); hand > 0 {
+		entry = logging.Hand(entry, hand)
+	}
+	return entry
+}
@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"context"
+	"github.com/hphphp123321/mahjong-goserver/metrics"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// onlineWindow is how long a client without an open stream still counts as online after its last request
+const onlineWindow = time.Minute
+
+// serverMetrics are kept per server so a restored server in the same process starts from zero
+type serverMetrics struct {
+	registry *metrics.Registry
+
+	rpcSeconds       *metrics.Histogram
+	streamsOpen      *metrics.Gauge
+	streamSendErrors *metrics.Counter
+	gamesStarted     *metrics.Counter
+	matchesFinished  *metrics.Counter
+	handsPlayed      *metrics.Counter
+}
+
+func newServerMetrics(s *MahjongServer) *serverMetrics {
+	reg := metrics.NewRegistry()
+	m := &serverMetrics{
+		registry: reg,
+		rpcSeconds: reg.NewHistogram("mahjong_rpc_duration_seconds",
+			"Time to handle an RPC, streams are measured until they close.", metrics.DefBuckets, "method", "code"),
+		streamsOpen: reg.NewGauge("mahjong_streams_open",
+			"Streams currently open.", "method"),
+		streamSendErrors: reg.NewCounter("mahjong_stream_send_errors_total",
+			"Messages that could not be sent on a stream.", "method"),
+		gamesStarted: reg.NewCounter("mahjong_games_started_total",
+			"Games started."),
+		matchesFinished: reg.NewCounter("mahjong_matches_finished_total",
+			"Matches finished and recorded."),
+		handsPlayed: reg.NewCounter("mahjong_hands_played_total",
+			"Hands played in finished matches."),
+	}
+	reg.NewGaugeFunc("mahjong_clients", "Logged in clients by state, online clients have a stream open or were seen within a minute.",
+		[]string{"state"}, func(emit func(float64, ...string)) {
+			emit(0, "online")
+			emit(0, "offline")
+			s.clientMu.RLock()
+			defer s.clientMu.RUnlock()
+			for _, c := range s.clients {
+				if c.getReadyStream() != nil || c.hasStartStream() || time.Since(c.lastSeen()) < onlineWindow {
+					emit(1, "online")
+				} else {
+					emit(1, "offline")
+				}
+			}
+		})
+	reg.NewGaugeFunc("mahjong_rooms", "Rooms by state.",
+		[]string{"state"}, func(emit func(float64, ...string)) {
+			for _, state := range []room.State{room.StateWaiting, room.StateStarting, room.StatePlaying, room.StateFinished} {
+				emit(0, state.String())
+			}
+			s.roomMu.RLock()
+			defer s.roomMu.RUnlock()
+			for _, r := range s.rooms {
+				if state := r.State(); state != room.StateClosed {
+					emit(1, state.String())
+				}
+			}
+		})
+	reg.NewGaugeFunc("mahjong_games_in_progress", "Rooms whose game is running.",
+		nil, func(emit func(float64, ...string)) {
+			emit(float64(len(s.runningRooms())))
+		})
+	reg.NewGaugeFunc("mahjong_queue_tickets", "Players waiting in the matchmaking queue by rule set.",
+		[]string{"rule_set"}, func(emit func(float64, ...string)) {
+			for ruleSet, n := range s.queue.Counts() {
+				emit(float64(n), ruleSet)
+			}
+		})
+	return m
+}
+
+// Metrics returns the registry of the server metrics, it is served next to metrics.Default
+func (s *MahjongServer) Metrics() *metrics.Registry {
+	return s.metrics.registry
+}
+
+// UnaryMetricsInterceptor measures the RPC, it goes first so refused calls are counted too
+func (s *MahjongServer) UnaryMetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	s.metrics.rpcSeconds.Observe(time.Since(start).Seconds(), info.FullMethod, status.Code(err).String())
+	return resp, err
+}
+
+// StreamMetricsInterceptor measures the stream and counts the messages it fails to send
+func (s *MahjongServer) StreamMetricsInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	s.metrics.streamsOpen.Add(1, info.FullMethod)
+	err := handler(srv, &measuredStream{ServerStream: ss, method: info.FullMethod, metrics: s.metrics})
+	s.metrics.streamsOpen.Add(-1, info.FullMethod)
+	s.metrics.rpcSeconds.Observe(time.Since(start).Seconds(), info.FullMethod, status.Code(err).String())
+	return err
+}
+
+type measuredStream struct {
+	grpc.ServerStream
+	method  string
+	metrics *serverMetrics
+}
+
+func (w *measuredStream) SendMsg(m interface{}) error {
+	err := w.ServerStream.SendMsg(m)
+	if err != nil {
+		w.metrics.streamSendErrors.Inc(w.method)
+	}
+	return err
+}
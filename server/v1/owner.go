@@ -0,0 +1,148 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	log "github.com/sirupsen/logrus"
+)
+
+func (s *MahjongServer) handleSwapSeatsRequest(c *client, in *pb.ReadyRequest) error {
+	r, err := s.getRoomByClient(c)
+	if err != nil {
+		return err
+	}
+	req := in.GetSwapSeats()
+	roomLog(c.log(), r).Debugf("SwapSeats Req: request: %s", req.String())
+	if err = r.SwapSeats(c.p, int(req.SeatA), int(req.SeatB)); err != nil {
+		return c.sendReadyMessage(fmt.Sprintf("player: %s, can't swap seats: %v", c.p.PlayerName, err))
+	}
+	rep := &pb.ReadyReply{
+		Message: fmt.Sprintf("player: %s, swap seat %d and %d", c.p.PlayerName, req.SeatA, req.SeatB),
+		Reply: &pb.ReadyReply_SwapSeats{SwapSeats: &pb.SwapSeatsReply{
+			SeatA: req.SeatA,
+			SeatB: req.SeatB,
+		}},
+	}
+	if err = s.readyBoardCast(c, rep, true); err != nil {
+		c.finish(err)
+		return err
+	}
+	roomLog(c.log(), r).WithFields(log.Fields{
+		logging.EventKey: "SwapSeats",
+		"SeatA":          req.SeatA,
+		"SeatB":          req.SeatB,
+	}).Info("Player Swap Seats success")
+	return nil
+}
+
+func (s *MahjongServer) handleMoveSeatRequest(c *client, in *pb.ReadyRequest) error {
+	r, err := s.getRoomByClient(c)
+	if err != nil {
+		return err
+	}
+	req := in.GetMoveSeat()
+	roomLog(c.log(), r).Debugf("MoveSeat Req: request: %s", req.String())
+	from := c.p.Seat
+	if err = r.MoveSeat(c.p, int(req.Seat)); err != nil {
+		return c.sendReadyMessage(fmt.Sprintf("player: %s, can't move seat: %v", c.p.PlayerName, err))
+	}
+	rep := &pb.ReadyReply{
+		Message: fmt.Sprintf("player: %s, move to seat %d", c.p.PlayerName, req.Seat),
+		Reply: &pb.ReadyReply_MoveSeat{MoveSeat: &pb.MoveSeatReply{
+			FromSeat:   int32(from),
+			ToSeat:     req.Seat,
+			PlayerName: c.p.PlayerName,
+		}},
+	}
+	if err = s.readyBoardCast(c, rep, true); err != nil {
+		c.finish(err)
+		return err
+	}
+	roomLog(c.log(), r).WithFields(log.Fields{
+		logging.EventKey: "MoveSeat",
+		logging.SeatKey:  req.Seat,
+	}).Info("Player Move Seat success")
+	return nil
+}
+
+func (s *MahjongServer) handleKickPlayerRequest(c *client, in *pb.ReadyRequest) error {
+	r, err := s.getRoomByClient(c)
+	if err != nil {
+		return err
+	}
+	req := in.GetKickPlayer()
+	roomLog(c.log(), r).Debugf("KickPlayer Req: request: %s", req.String())
+	kicked, err := r.Kick(c.p, int(req.PlayerSeat), req.Ban)
+	if err != nil {
+		return c.sendReadyMessage(fmt.Sprintf("player: %s, can't kick player: %v", c.p.PlayerName, err))
+	}
+	rep := &pb.ReadyReply{
+		Message: fmt.Sprintf("player: %s, kick player: %s", c.p.PlayerName, kicked.PlayerName),
+		Reply: &pb.ReadyReply_KickPlayer{KickPlayer: &pb.KickPlayerReply{
+			Seat:       req.PlayerSeat,
+			PlayerName: kicked.PlayerName,
+			Banned:     req.Ban && !kicked.IsRobot(),
+		}},
+	}
+	s.detachPlayer(kicked.Token, rep)
+	if err = s.readyBoardCast(c, rep, true); err != nil {
+		c.finish(err)
+		return err
+	}
+	roomLog(c.log(), r).WithFields(log.Fields{
+		logging.EventKey:   "KickPlayer",
+		"KickedPlayerName": kicked.PlayerName,
+		"Ban":              req.Ban,
+	}).Info("Player Kick Player success")
+	return nil
+}
+
+func (s *MahjongServer) handleTransferOwnerRequest(c *client, in *pb.ReadyRequest) error {
+	r, err := s.getRoomByClient(c)
+	if err != nil {
+		return err
+	}
+	req := in.GetTransferOwner()
+	roomLog(c.log(), r).Debugf("TransferOwner Req: request: %s", req.String())
+	owner, err := r.TransferOwner(c.p, int(req.Seat))
+	if err != nil {
+		return c.sendReadyMessage(fmt.Sprintf("player: %s, can't transfer owner: %v", c.p.PlayerName, err))
+	}
+	rep := &pb.ReadyReply{
+		Message: fmt.Sprintf("player: %s, transfer owner to: %s", c.p.PlayerName, owner.PlayerName),
+		Reply: &pb.ReadyReply_TransferOwner{TransferOwner: &pb.TransferOwnerReply{
+			OwnerSeat: int32(owner.Seat),
+			OwnerName: owner.PlayerName,
+		}},
+	}
+	if err = s.readyBoardCast(c, rep, true); err != nil {
+		c.finish(err)
+		return err
+	}
+	roomLog(c.log(), r).WithFields(log.Fields{
+		logging.EventKey: "TransferOwner",
+		"OwnerName":      owner.PlayerName,
+	}).Info("Player Transfer Owner success")
+	return nil
+}
+
+// detachPlayer tells a human player removed from a room why they left, resets their room state
+// and ends their ready stream, robots have no client and are skipped
+func (s *MahjongServer) detachPlayer(token uuid.UUID, rep *pb.ReadyReply) {
+	if token == uuid.Nil {
+		return
+	}
+	s.clientMu.RLock()
+	kc, ok := s.clients[token]
+	s.clientMu.RUnlock()
+	if !ok {
+		return
+	}
+	if err := kc.sendReadyReply(rep); err != nil && !errors.Is(err, errNoReadyStream) {
+		kc.log().Warningf("send kick reply failed: %v", err)
+	}
+	kc.leaveRoom()
+}
@@ -0,0 +1,111 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	"github.com/hphphp123321/mahjong-goserver/protocol"
+	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+	"strings"
+)
+
+// SetMinProtocol sets the oldest protocol version the server accepts, older clients are refused
+func (s *MahjongServer) SetMinProtocol(v protocol.Version) {
+	s.protocolMu.Lock()
+	s.minProtocol = v
+	s.protocolMu.Unlock()
+}
+
+func (s *MahjongServer) getMinProtocol() protocol.Version {
+	s.protocolMu.RLock()
+	defer s.protocolMu.RUnlock()
+	return s.minProtocol
+}
+
+// clientProtocol reads the protocol version from the metadata, a client without it was built before the handshake
+func clientProtocol(ctx context.Context) (protocol.Version, error) {
+	headers, _ := metadata.FromIncomingContext(ctx)
+	versions := headers.Get(protocol.Header)
+	if len(versions) == 0 {
+		return protocol.Legacy, nil
+	}
+	return protocol.Parse(versions[0])
+}
+
+// checkProtocol refuses the call of a client the server can't serve
+func (s *MahjongServer) checkProtocol(ctx context.Context) error {
+	v, err := clientProtocol(ctx)
+	if err != nil {
+		return err
+	}
+	_, _, err = protocol.Check(v, s.getMinProtocol())
+	return err
+}
+
+func (s *MahjongServer) Hello(ctx context.Context, in *pb.HelloRequest) (*pb.HelloReply, error) {
+	var v protocol.Version
+	var err error
+	if in.ProtocolVersion != "" {
+		v, err = protocol.Parse(in.ProtocolVersion)
+	} else {
+		v, err = clientProtocol(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	min := s.getMinProtocol()
+	support, notice, err := protocol.Check(v, min)
+	entry := logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey: "Hello",
+		"ClientName":     in.ClientName,
+		"Protocol":       v.String(),
+		"Features":       strings.Join(in.Features, ","),
+	})
+	if err != nil {
+		entry.WithError(err).Warn("client refused")
+		return nil, err
+	}
+	features, missing := protocol.Negotiate(in.Features)
+	entry.WithField("Support", support.String()).Info("client hello")
+
+	message := fmt.Sprintf("protocol %s is %s", v, support)
+	if notice != "" {
+		message = notice
+	}
+	if len(missing) > 0 {
+		message += fmt.Sprintf(", not available on this server: %s", strings.Join(missing, ", "))
+	}
+	return &pb.HelloReply{
+		Message:            message,
+		ProtocolVersion:    protocol.Current.String(),
+		MinProtocolVersion: min.String(),
+		Support:            pbSupport(support),
+		Features:           features,
+		MissingFeatures:    missing,
+		Matrix:             pbMatrix(protocol.MatrixFrom(min)),
+	}, nil
+}
+
+func pbSupport(support protocol.Support) pb.ProtocolSupport {
+	switch support {
+	case protocol.Deprecated:
+		return pb.ProtocolSupport_ProtocolDeprecated
+	case protocol.Unsupported:
+		return pb.ProtocolSupport_ProtocolUnsupported
+	}
+	return pb.ProtocolSupport_ProtocolSupported
+}
+
+func pbMatrix(releases []protocol.Release) []*pb.ProtocolRelease {
+	matrix := make([]*pb.ProtocolRelease, 0, len(releases))
+	for _, r := range releases {
+		matrix = append(matrix, &pb.ProtocolRelease{
+			Version: r.Version.String(),
+			Support: pbSupport(r.Support),
+			Note:    r.Note,
+		})
+	}
+	return matrix
+}
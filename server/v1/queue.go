@@ -0,0 +1,233 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	"github.com/hphphp123321/mahjong-goserver/matchmaking"
+	"github.com/hphphp123321/mahjong-goserver/player"
+	"github.com/hphphp123321/mahjong-goserver/robots"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+const (
+	matchInterval       = time.Second
+	queueStatusInterval = 5 * time.Second
+)
+
+// SetQueueOptions changes how the matchmaking queue widens bands and fills tables with robots
+func (s *MahjongServer) SetQueueOptions(opts matchmaking.Options) {
+	s.queue.SetOptions(opts)
+}
+
+func (s *MahjongServer) JoinQueue(in *pb.JoinQueueRequest, stream pb.Mahjong_JoinQueueServer) error {
+	ctx := stream.Context()
+	c, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if c.p.RoomID != uuid.Nil {
+		return errAlreadyInRoom
+	}
+	ruleSet := room.DefaultRuleSet
+	if in.RuleSet != nil && *in.RuleSet != "" {
+		ruleSet = *in.RuleSet
+	}
+	if _, err = s.ruleSetPreset(ruleSet); err != nil {
+		return err
+	}
+	profile, err := s.store.GetProfile(c.p.PlayerName)
+	if err != nil {
+		return err
+	}
+	t := matchmaking.NewTicket(c.p.Token, c.p.PlayerName, ruleSet, s.rating.Value(profile.Rating(s.rating)), in.AllowRobots)
+	if err = s.queue.Join(t); err != nil {
+		return err
+	}
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey: "JoinQueue",
+		"RuleSet":        ruleSet,
+	}).Info("player join queue")
+
+	ticker := time.NewTicker(queueStatusInterval)
+	defer ticker.Stop()
+	if err = stream.Send(s.newQueueStatusReply(t)); err != nil {
+		_ = s.queue.Leave(t.Token)
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			_ = s.queue.Leave(t.Token)
+			return ctx.Err()
+		case <-t.Left:
+			if err = t.Err(); err != nil {
+				return err
+			}
+			return stream.Send(&pb.QueueReply{
+				Message: "leave queue",
+				Reply:   &pb.QueueReply_Left{Left: &pb.Empty{}},
+			})
+		case roomID := <-t.Matched:
+			s.roomMu.RLock()
+			r, ok := s.rooms[roomID]
+			s.roomMu.RUnlock()
+			if !ok {
+				return errRoomNotFound
+			}
+			return stream.Send(&pb.QueueReply{
+				Message: fmt.Sprintf("match found, room: %s", r.RoomName),
+				Reply: &pb.QueueReply_Matched{Matched: &pb.QueueMatchedReply{
+					Room: newPbRoom(r),
+					Seat: int32(c.p.Seat),
+				}},
+			})
+		case <-ticker.C:
+			if err = stream.Send(s.newQueueStatusReply(t)); err != nil {
+				_ = s.queue.Leave(t.Token)
+				return err
+			}
+		}
+	}
+}
+
+func (s *MahjongServer) LeaveQueue(ctx context.Context, in *pb.Empty) (*pb.LeaveQueueReply, error) {
+	c, err := clientFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.queue.Leave(c.p.Token); err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey: "LeaveQueue",
+	}).Info("player leave queue")
+	return &pb.LeaveQueueReply{
+		Message: "leave queue success",
+	}, nil
+}
+
+func (s *MahjongServer) newQueueStatusReply(t *matchmaking.Ticket) *pb.QueueReply {
+	now := time.Now()
+	return &pb.QueueReply{
+		Message: "waiting for players",
+		Reply: &pb.QueueReply_Status{Status: &pb.QueueStatusReply{
+			WaitingPlayers: int32(s.queue.Len(t.RuleSet)),
+			WaitedSeconds:  int64(now.Sub(t.JoinedAt).Seconds()),
+			Band:           s.queue.Band(t, now),
+		}},
+	}
+}
+
+// runMatchmaking seats the groups found by the queue until the server stops
+func (s *MahjongServer) runMatchmaking() {
+	ticker := time.NewTicker(matchInterval)
+	defer ticker.Stop()
+	for {
+		var now time.Time
+		select {
+		case <-s.stop:
+			return
+		case now = <-ticker.C:
+		}
+		for _, g := range s.queue.Match(now) {
+			if err := s.seatGroup(g); err != nil {
+				log.WithFields(log.Fields{
+					logging.EventKey: "Matchmaking",
+					"RuleSet":        g.RuleSet,
+				}).Warningf("seat group failed: %v", err)
+			}
+		}
+	}
+}
+
+// seatGroup creates the room of a matched group, fills it with robots and tells
+// every ticket where it was seated. Players that logged out meanwhile send the rest back to the queue,
+// the tickets of players that got into a room meanwhile or of a table that can't be set up fail.
+// The players are claimed while the room is set up so they can't create or join another one
+func (s *MahjongServer) seatGroup(g *matchmaking.Group) error {
+	clients := make([]*client, 0, len(g.Tickets))
+	live := make([]*matchmaking.Ticket, 0, len(g.Tickets))
+	seated := make([]*matchmaking.Ticket, 0)
+	s.clientMu.RLock()
+	for _, t := range g.Tickets {
+		c, ok := s.clients[t.Token]
+		if !ok {
+			continue
+		}
+		if !c.claimSeat() {
+			seated = append(seated, t)
+			continue
+		}
+		clients = append(clients, c)
+		live = append(live, t)
+	}
+	s.clientMu.RUnlock()
+	for _, t := range seated {
+		t.Fail(errAlreadyInRoom)
+	}
+	defer func() {
+		for _, c := range clients {
+			c.releaseSeat()
+		}
+	}()
+	if len(clients) < len(g.Tickets) {
+		for _, t := range live {
+			_ = s.queue.Join(t)
+		}
+		return nil
+	}
+
+	r, err := s.newMatchRoom(g, clients)
+	if err != nil {
+		for _, t := range live {
+			t.Fail(toStatus(err))
+		}
+		return err
+	}
+	roomID := r.RoomID
+	s.roomMu.Lock()
+	s.rooms[roomID] = r
+	s.roomMu.Unlock()
+	s.lobby.add(r)
+	for i, c := range clients {
+		c.enterRoom(roomID)
+		live[i].Matched <- roomID
+	}
+	roomLog(serverLog(), r).WithFields(log.Fields{
+		logging.EventKey: "Matchmaking",
+		"RuleSet":        r.RuleSet,
+		"Robots":         g.Robots,
+	}).Info("match found")
+	return nil
+}
+
+// newMatchRoom sets up the room of a group without putting it into the server
+func (s *MahjongServer) newMatchRoom(g *matchmaking.Group, clients []*client) (*room.Room, error) {
+	roomID := uuid.New()
+	r := room.NewRoom(roomID, fmt.Sprintf("match-%s", roomID.String()[:8]), clients[0].p)
+	r.RuleSet = g.RuleSet
+	if preset, _ := s.ruleSetPreset(g.RuleSet); preset != nil {
+		preset.Apply(r)
+	}
+	for _, c := range clients {
+		if err := r.AddPlayer(c.p); err != nil {
+			return nil, err
+		}
+	}
+	level := s.queue.Options().RobotLevel
+	for i := 0; i < g.Robots; i++ {
+		agent, err := robots.GetRobot(level)
+		if err != nil {
+			return nil, err
+		}
+		if err = r.AddRobot(player.NewRobot(level, r.IdleSeats[0], agent)); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
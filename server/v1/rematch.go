@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	log "github.com/sirupsen/logrus"
+)
+
+// handleRematchRequest records a rematch vote of a finished room. When every seated player
+// accepts and the table is full the next match starts with the rematch seating, open seats
+// send the room back to waiting with the voters ready, a decline cancels the rematch
+func (s *MahjongServer) handleRematchRequest(c *client, in *pb.ReadyRequest) error {
+	r, err := s.getRoomByClient(c)
+	if err != nil {
+		return err
+	}
+	accept := in.GetRematch().Accept
+	roomLog(c.log(), r).Debugf("Rematch Req: accept: %v", accept)
+	vote, err := r.VoteRematch(c.p, accept)
+	if err != nil {
+		return c.sendReadyMessage(fmt.Sprintf("player: %s, can't vote rematch: %v", c.p.PlayerName, err))
+	}
+	rep := &pb.ReadyReply{
+		Message: fmt.Sprintf("player: %s, vote rematch: %v", c.p.PlayerName, accept),
+		Reply: &pb.ReadyReply_Rematch{Rematch: &pb.RematchReply{
+			Seat:       int32(c.p.Seat),
+			PlayerName: c.p.PlayerName,
+			Accept:     accept,
+			Votes:      int32(vote.Votes),
+			Needed:     int32(vote.Needed),
+		}},
+	}
+	if err = s.readyBoardCast(c, rep, true); err != nil {
+		c.finish(err)
+		return err
+	}
+	if !vote.Decided {
+		return nil
+	}
+	if err = r.Transition(room.StateWaiting); err != nil {
+		return err
+	}
+	for _, p := range r.Players {
+		p.SetReady(vote.Accepted || p.IsRobot())
+	}
+	roomLog(serverLog(), r).WithFields(log.Fields{
+		logging.EventKey: "Rematch",
+		"Accepted":       vote.Accepted,
+	}).Info("rematch vote decided")
+	if !vote.Accepted {
+		return nil
+	}
+	if !r.IsFull() {
+		return s.readyBoardCast(c, &pb.ReadyReply{
+			Message: fmt.Sprintf("room: %s, rematch accepted, waiting for players to fill the open seats", r.RoomName),
+		}, true)
+	}
+	if err = r.Transition(room.StateStarting); err != nil {
+		return err
+	}
+	seats := r.Seating()
+	if err = s.startGame(r, r.RematchSeating); err != nil {
+		s.rollbackStart(r, seats, err)
+		roomLog(serverLog(), r).WithFields(log.Fields{
+			logging.EventKey: "Rematch",
+		}).Warningf("start rematch failed: %v", err)
+	}
+	return nil
+}
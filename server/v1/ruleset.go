@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"github.com/hphphp123321/mahjong-goserver/room"
+)
+
+// SetRuleSets replaces the rule set presets, once presets are set only their names and the default rule set are accepted
+func (s *MahjongServer) SetRuleSets(ruleSets []room.RuleSet) {
+	presets := make(map[string]room.RuleSet, len(ruleSets))
+	for _, rs := range ruleSets {
+		presets[rs.Name] = rs
+	}
+	s.ruleSetMu.Lock()
+	s.ruleSets = presets
+	s.ruleSetMu.Unlock()
+}
+
+// ruleSetPreset returns the preset of the rule set, nil if it has none
+func (s *MahjongServer) ruleSetPreset(name string) (*room.RuleSet, error) {
+	if name == "" {
+		name = room.DefaultRuleSet
+	}
+	s.ruleSetMu.RLock()
+	defer s.ruleSetMu.RUnlock()
+	if rs, ok := s.ruleSets[name]; ok {
+		return &rs, nil
+	}
+	if len(s.ruleSets) > 0 && name != room.DefaultRuleSet {
+		return nil, room.ErrUnknownRuleSet
+	}
+	return nil, nil
+}
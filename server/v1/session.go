@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	"github.com/hphphp123321/mahjong-goserver/storage"
+)
+
+// GetSessionSummary returns the cumulative table of a room, closed rooms are read from the store.
+// The session of a private room is only shown to its players, others get errRoomNotFound
+func (s *MahjongServer) GetSessionSummary(ctx context.Context, in *pb.GetSessionSummaryRequest) (*pb.GetSessionSummaryReply, error) {
+	c, err := clientFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roomID := c.p.RoomID
+	if in.RoomID != "" {
+		if roomID, err = uuid.Parse(in.RoomID); err != nil {
+			return nil, errRoomNotFound
+		}
+	}
+	if roomID == uuid.Nil {
+		return nil, errNotInRoom
+	}
+	var session *room.Session
+	s.roomMu.RLock()
+	r, ok := s.rooms[roomID]
+	s.roomMu.RUnlock()
+	if ok {
+		session = r.SessionTable()
+	} else if session, err = s.store.GetSession(roomID); err != nil {
+		if errors.Is(err, storage.ErrSessionNotFound) {
+			return nil, errRoomNotFound
+		}
+		return nil, err
+	}
+	if session.Private && roomID != c.p.RoomID && !session.HasPlayer(c.p.PlayerName) {
+		return nil, errRoomNotFound
+	}
+
+	reply := &pb.GetSessionSummaryReply{
+		Message: "get session summary success",
+		Summary: newPbSessionSummary(session),
+	}
+	switch in.Export {
+	case pb.ExportFormat_CSV:
+		var buf bytes.Buffer
+		if err = session.WriteCSV(&buf); err != nil {
+			return nil, err
+		}
+		reply.Export = buf.String()
+	case pb.ExportFormat_JSON:
+		data, err := json.MarshalIndent(struct {
+			*room.Session
+			Standings []room.SessionStanding `json:"standings"`
+		}{session, session.Standings()}, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		reply.Export = string(data)
+	}
+	return reply, nil
+}
+
+func newPbSessionSummary(session *room.Session) *pb.SessionSummary {
+	summary := &pb.SessionSummary{
+		RoomID:    session.RoomID.String(),
+		RoomName:  session.RoomName,
+		StartedAt: session.StartedAt.Unix(),
+		Scoring: &pb.SessionScoring{
+			StartPoints:  int32(session.Scoring.StartPoints),
+			ReturnPoints: int32(session.Scoring.ReturnPoints),
+			Uma:          session.Scoring.Uma[:],
+		},
+	}
+	for _, st := range session.Standings() {
+		standing := &pb.SessionStanding{
+			PlayerName:    st.PlayerName,
+			Robot:         st.Robot,
+			MatchCount:    int32(st.Matches),
+			TotalScore:    int64(st.Score),
+			AdjustedScore: st.Adjusted,
+		}
+		for _, n := range st.Placements {
+			standing.Placements = append(standing.Placements, int32(n))
+		}
+		summary.Standings = append(summary.Standings, standing)
+	}
+	for _, m := range session.Matches {
+		match := &pb.SessionMatch{
+			MatchID:    m.MatchID.String(),
+			FinishedAt: m.FinishedAt.Unix(),
+		}
+		for _, res := range m.Results {
+			match.Results = append(match.Results, &pb.SessionResult{
+				PlayerName:    res.PlayerName,
+				Seat:          int32(res.Seat),
+				Robot:         res.Robot,
+				Score:         int32(res.Score),
+				Placement:     int32(res.Placement),
+				AdjustedScore: res.Adjusted,
+			})
+		}
+		summary.Matches = append(summary.Matches, match)
+	}
+	return summary
+}
@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"context"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+// drainInterval is how often Shutdown checks for running games
+const drainInterval = 500 * time.Millisecond
+
+// Shutdown drains the server: logins, new rooms, queue tickets and new games are refused and every
+// online player gets the notice. Running games have until the deadline of ctx to finish, then the
+// ready streams are ended and the store is synced. Stopping the grpc server is left to the caller
+func (s *MahjongServer) Shutdown(ctx context.Context, notice string) error {
+	if !s.draining.CompareAndSwap(false, true) {
+		return errShuttingDown
+	}
+	close(s.stop)
+	tickets := s.queue.Clear()
+	n := s.announce(notice)
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey: "Shutdown",
+		"Recipients":     n,
+		"Tickets":        len(tickets),
+	}).Info("server is draining")
+
+	ticker := time.NewTicker(drainInterval)
+	defer ticker.Stop()
+	for running := s.runningRooms(); len(running) > 0; running = s.runningRooms() {
+		select {
+		case <-ctx.Done():
+			for _, r := range running {
+				roomLog(logging.FromContext(ctx), r).WithFields(log.Fields{
+					logging.EventKey: "Shutdown",
+					"State":          r.State().String(),
+				}).Warning("game still running at the shutdown deadline")
+			}
+			return s.finishShutdown()
+		case <-ticker.C:
+		}
+	}
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey: "Shutdown",
+	}).Info("every game finished")
+	return s.finishShutdown()
+}
+
+// Draining reports whether Shutdown was called
+func (s *MahjongServer) Draining() bool {
+	return s.draining.Load()
+}
+
+func (s *MahjongServer) finishShutdown() error {
+	s.clientMu.RLock()
+	for _, c := range s.clients {
+		c.close()
+	}
+	s.clientMu.RUnlock()
+	return s.store.Sync()
+}
+
+// runningRooms returns the rooms whose game is being set up or played
+func (s *MahjongServer) runningRooms() []*room.Room {
+	s.roomMu.RLock()
+	defer s.roomMu.RUnlock()
+	var running []*room.Room
+	for _, r := range s.rooms {
+		if st := r.State(); st == room.StateStarting || st == room.StatePlaying {
+			running = append(running, r)
+		}
+	}
+	return running
+}
@@ -0,0 +1,220 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	"github.com/hphphp123321/mahjong-goserver/player"
+	"github.com/hphphp123321/mahjong-goserver/robots"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	"github.com/hphphp123321/mahjong-goserver/tournament"
+	log "github.com/sirupsen/logrus"
+	"os"
+	"time"
+)
+
+// snapshotVersion changes when an older snapshot can't be restored anymore
+const snapshotVersion = 1
+
+type clientSnapshot struct {
+	PlayerName string    `json:"player_name"`
+	Session    uuid.UUID `json:"session"` // tokens signed for the session stay valid
+	RoomID     uuid.UUID `json:"room_id"`
+	Rank       string    `json:"rank"`
+}
+
+// serverSnapshot is the state of the server that is not kept by the store,
+// the matchmaking queue is not part of it as Shutdown empties it
+type serverSnapshot struct {
+	Version     int                   `json:"version"`
+	TakenAt     time.Time             `json:"taken_at"`
+	Clients     []clientSnapshot      `json:"clients"`
+	Rooms       []room.Snapshot       `json:"rooms"`
+	Tournaments []tournament.Snapshot `json:"tournaments"`
+	Banned      map[string]string     `json:"banned"`
+}
+
+// WriteSnapshot saves the clients, rooms, tournaments and bans to the file, it is meant to run
+// after Shutdown. Games still running are saved as interrupted, the engine state is not saved
+func (s *MahjongServer) WriteSnapshot(path string) error {
+	snap := serverSnapshot{
+		Version: snapshotVersion,
+		TakenAt: time.Now(),
+	}
+	s.clientMu.RLock()
+	for _, c := range s.clients {
+		snap.Clients = append(snap.Clients, clientSnapshot{
+			PlayerName: c.p.PlayerName,
+			Session:    c.p.Token,
+			RoomID:     c.p.RoomID,
+			Rank:       c.p.Rank,
+		})
+	}
+	s.clientMu.RUnlock()
+	s.roomMu.RLock()
+	for _, r := range s.rooms {
+		if r.State() != room.StateClosed {
+			snap.Rooms = append(snap.Rooms, r.Snapshot())
+		}
+	}
+	s.roomMu.RUnlock()
+	s.tournamentMu.RLock()
+	for _, t := range s.tournaments {
+		snap.Tournaments = append(snap.Tournaments, t.Snapshot())
+	}
+	s.tournamentMu.RUnlock()
+	s.adminMu.RLock()
+	snap.Banned = make(map[string]string, len(s.banned))
+	for name, reason := range s.banned {
+		snap.Banned[name] = reason
+	}
+	s.adminMu.RUnlock()
+
+	data, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return err
+	}
+	// write to a temporary file first so a crash never leaves half a snapshot
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		logging.EventKey: "Snapshot",
+		"Clients":        len(snap.Clients),
+		"Rooms":          len(snap.Rooms),
+		"Tournaments":    len(snap.Tournaments),
+	}).Info("snapshot written")
+	return nil
+}
+
+// RestoreSnapshot loads the snapshot written by WriteSnapshot before the server starts serving,
+// players reconnecting with their token are back in their room and seat. The file is removed
+// once restored so a later crash doesn't bring back stale state. A missing file is not an error
+func (s *MahjongServer) RestoreSnapshot(path string) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var snap serverSnapshot
+	if err = json.Unmarshal(data, &snap); err != nil {
+		return err
+	}
+	if snap.Version != snapshotVersion {
+		return fmt.Errorf("snapshot version %d is not supported", snap.Version)
+	}
+
+	sessions := make(map[uuid.UUID]bool, len(snap.Clients))
+	for _, cs := range snap.Clients {
+		sessions[cs.Session] = true
+	}
+	players := make(map[uuid.UUID]*player.Player)
+	rooms := make(map[uuid.UUID]*room.Room, len(snap.Rooms))
+	for _, rs := range snap.Rooms {
+		// seats of players whose session is gone would never be freed
+		seats := rs.Seats[:0]
+		for _, seat := range rs.Seats {
+			if seat.Robot || sessions[seat.Token] {
+				seats = append(seats, seat)
+			}
+		}
+		rs.Seats = seats
+		r, err := room.Restore(rs, newSnapshotRobot)
+		if err != nil {
+			return fmt.Errorf("restore room %s: %w", rs.RoomName, err)
+		}
+		if r.HumanCount() == 0 {
+			continue
+		}
+		if rs.State == room.StateStarting || rs.State == room.StatePlaying {
+			roomLog(serverLog(), r).WithFields(log.Fields{
+				logging.EventKey: "RestoreSnapshot",
+			}).Warning("game interrupted by the restart, the room is waiting again")
+		}
+		for _, p := range r.Players {
+			if !p.IsRobot() {
+				players[p.Token] = p
+			}
+		}
+		rooms[r.RoomID] = r
+	}
+
+	clients := make(map[uuid.UUID]*client, len(snap.Clients))
+	for _, cs := range snap.Clients {
+		c := newClient(cs.PlayerName, cs.Session)
+		if p, ok := players[cs.Session]; ok {
+			c.p = p
+		}
+		c.p.Rank = cs.Rank
+		if _, ok := rooms[c.p.RoomID]; !ok {
+			c.p.RoomID = uuid.Nil
+			c.p.SetReady(false)
+		}
+		clients[cs.Session] = c
+	}
+
+	s.clientMu.Lock()
+	for session, c := range clients {
+		s.clients[session] = c
+	}
+	s.clientMu.Unlock()
+	s.roomMu.Lock()
+	for id, r := range rooms {
+		s.rooms[id] = r
+	}
+	s.roomMu.Unlock()
+	for _, r := range rooms {
+		s.lobby.add(r)
+	}
+	restored := make([]*tournament.Tournament, 0, len(snap.Tournaments))
+	s.tournamentMu.Lock()
+	for _, ts := range snap.Tournaments {
+		t := tournament.Restore(ts)
+		s.tournaments[t.ID] = t
+		restored = append(restored, t)
+	}
+	s.tournamentMu.Unlock()
+	for _, t := range restored {
+		// tables whose room had no player left to restore are seated again,
+		// they are forfeited if none of their players is back
+		var orphans []*tournament.Table
+		for _, table := range t.Tables() {
+			if _, ok := rooms[table.RoomID]; !ok && !table.Done && table.RoomID != uuid.Nil {
+				orphans = append(orphans, table)
+			}
+		}
+		s.seatTables(t, orphans)
+	}
+	s.adminMu.Lock()
+	for name, reason := range snap.Banned {
+		s.banned[name] = reason
+	}
+	s.adminMu.Unlock()
+
+	if err = os.Remove(path); err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		logging.EventKey: "RestoreSnapshot",
+		"TakenAt":        snap.TakenAt.Format(time.RFC3339),
+		"Clients":        len(clients),
+		"Rooms":          len(rooms),
+		"Tournaments":    len(snap.Tournaments),
+	}).Info("snapshot restored")
+	return nil
+}
+
+func newSnapshotRobot(level string, seat int) (*player.Player, error) {
+	agent, err := robots.GetRobot(level)
+	if err != nil {
+		return nil, err
+	}
+	return player.NewRobot(level, seat, agent), nil
+}
@@ -0,0 +1,272 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	"github.com/hphphp123321/mahjong-goserver/tracing"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"io"
+)
+
+// Start keeps the game stream of a player open, game messages are pushed to it by the room
+func (s *MahjongServer) Start(stream pb.Mahjong_StartServer) error {
+	c, err := clientFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	c.startMu.Lock()
+	if c.startStream != nil {
+		c.startMu.Unlock()
+		return errHasStartStream
+	}
+	c.startStream = stream
+	c.startMu.Unlock()
+	defer func() {
+		c.startMu.Lock()
+		c.startStream = nil
+		c.startMu.Unlock()
+	}()
+	c.log().Info("StartStream started")
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if msg, ok := s.checkRoomState(c, in, startRequestStates(in)); !ok {
+			if err = c.sendStartReply(&pb.StartReply{Message: msg}); err != nil {
+				return err
+			}
+			continue
+		}
+		switch in.GetRequest().(type) {
+		case *pb.StartRequest_Ping:
+			err = c.sendStartReply(&pb.StartReply{
+				Message: "pong",
+				Reply:   &pb.StartReply_Pong{Pong: in.GetPing()},
+			})
+		case *pb.StartRequest_Chat:
+			r, rErr := s.getRoomByClient(c)
+			if rErr != nil {
+				continue
+			}
+			// a player that can't receive the chat doesn't end the stream of the sender
+			_ = s.startBoardCast(r, &pb.StartReply{
+				Message: fmt.Sprintf("player: %s, send chat message", c.p.PlayerName),
+				Reply: &pb.StartReply_Chat{Chat: &pb.ChatReply{
+					Message:    in.GetChat().Message,
+					PlayerName: c.p.PlayerName,
+				}},
+			})
+		default:
+			c.log().Debugf("StartStream: no game running for request: %s", in.String())
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// startBoardCast sends the reply to the start stream of every human player of the room,
+// it keeps sending after a failure and returns the first error
+func (s *MahjongServer) startBoardCast(r *room.Room, rep *pb.StartReply) error {
+	clients, err := s.roomClients(r)
+	for _, rc := range clients {
+		if sErr := rc.sendStartReply(rep); sErr != nil {
+			roomLog(rc.log(), r).Warningf("send start reply failed: %v", sErr)
+			if err == nil {
+				err = sErr
+			}
+		}
+	}
+	return err
+}
+
+// handleStartGameRequest starts the game when the owner asks for it, the room is full
+// and every player is ready, a failed start puts the room back to waiting
+func (s *MahjongServer) handleStartGameRequest(c *client, in *pb.ReadyRequest) error {
+	r, err := s.getRoomByClient(c)
+	if err != nil {
+		return err
+	}
+	roomLog(c.log(), r).Debugf("StartGame Req")
+	if r.Owner != c.p {
+		return c.sendReadyMessage(fmt.Sprintf("player: %s, is not owner, can't start game", c.p.PlayerName))
+	}
+	if !r.IsFull() {
+		return c.sendReadyMessage(fmt.Sprintf("room: %s, is not full, can't start game", r.RoomName))
+	}
+	if !r.CheckAllReady() {
+		return c.sendReadyMessage(fmt.Sprintf("room: %s, not every player is ready, can't start game", r.RoomName))
+	}
+	if err = r.Transition(room.StateStarting); err != nil {
+		return c.sendReadyMessage(fmt.Sprintf("player: %s, can't start game: %v", c.p.PlayerName, err))
+	}
+	seats := r.Seating()
+	if err = s.startGame(r, r.SeatDraw); err != nil {
+		s.rollbackStart(r, seats, err)
+		roomLog(serverLog(), r).WithFields(log.Fields{
+			logging.EventKey: "StartGame",
+		}).Warningf("start game failed: %v", err)
+		return nil
+	}
+	roomLog(c.log(), r).WithFields(log.Fields{
+		logging.EventKey: "StartGame",
+	}).Info("Player Start Game success")
+	return nil
+}
+
+// startGame moves the players of a starting room from the ready stream to the start stream,
+// nothing is sent before every human player has a start stream open
+func (s *MahjongServer) startGame(r *room.Room, seating room.SeatDraw) (err error) {
+	_, span := tracing.StartTurn(context.Background(), tracing.SpanGameStart, tracing.RoomTurn(r.RoomID))
+	span.SetAttributes(
+		tracing.MatchKey.Int(len(r.SessionTable().Matches)+1),
+		attribute.Int("mahjong.seat_draw", int(seating)),
+	)
+	defer func() {
+		tracing.End(span, err)
+	}()
+	if s.draining.Load() {
+		return errShuttingDown
+	}
+	clients, err := s.roomClients(r)
+	if err != nil {
+		return err
+	}
+	for _, rc := range clients {
+		if !rc.hasStartStream() {
+			return fmt.Errorf("player: %s, has no start stream", rc.p.PlayerName)
+		}
+	}
+	if err = s.drawSeats(r, seating); err != nil {
+		return err
+	}
+	err = s.startBoardCast(r, &pb.StartReply{
+		Message: fmt.Sprintf("room: %s, game start", r.RoomName),
+		Reply:   &pb.StartReply_GameStart{GameStart: r.RoomID.String()},
+	})
+	if err != nil {
+		return err
+	}
+	if err = r.Transition(room.StatePlaying); err != nil {
+		return err
+	}
+	s.metrics.gamesStarted.Inc()
+	// the ready streams hear of the start once nothing can fail anymore
+	rep := &pb.ReadyReply{
+		Message: fmt.Sprintf("room: %s, game start", r.RoomName),
+		Reply:   &pb.ReadyReply_StartGame{StartGame: &pb.Empty{}},
+	}
+	for _, rc := range clients {
+		if sErr := rc.sendReadyReply(rep); sErr != nil && !errors.Is(sErr, errNoReadyStream) {
+			roomLog(rc.log(), r).Warningf("send game start failed: %v", sErr)
+		}
+	}
+	return nil
+}
+
+// rollbackStart puts a room whose start failed back to waiting with the seats it had before the draw
+// and tells the players why on both streams
+func (s *MahjongServer) rollbackStart(r *room.Room, seats *room.Seating, cause error) {
+	if err := r.RestoreSeating(seats); err != nil {
+		roomLog(serverLog(), r).Warningf("restore seats failed: %v", err)
+	}
+	if err := r.Transition(room.StateWaiting); err != nil {
+		roomLog(serverLog(), r).Warningf("roll back room failed: %v", err)
+	}
+	msg := fmt.Sprintf("room: %s, start game cancelled: %v", r.RoomName, cause)
+	// the start streams may have seen the seat draw already
+	if err := s.startBoardCast(r, &pb.StartReply{Message: msg}); err != nil {
+		roomLog(serverLog(), r).Warningf("send start cancel failed: %v", err)
+	}
+	clients, _ := s.roomClients(r)
+	rep := &pb.ReadyReply{
+		Message: msg,
+	}
+	for _, rc := range clients {
+		if err := rc.sendReadyReply(rep); err != nil && !errors.Is(err, errNoReadyStream) {
+			roomLog(rc.log(), r).Warningf("send start cancel failed: %v", err)
+		}
+	}
+}
+
+// roomClients returns the clients of the human players of the room
+func (s *MahjongServer) roomClients(r *room.Room) ([]*client, error) {
+	s.clientMu.RLock()
+	defer s.clientMu.RUnlock()
+	clients := make([]*client, 0, len(r.Players))
+	for _, p := range r.Players {
+		if p.IsRobot() {
+			continue
+		}
+		c, ok := s.clients[p.Token]
+		if !ok {
+			return clients, fmt.Errorf("player: %s, is offline", p.PlayerName)
+		}
+		clients = append(clients, c)
+	}
+	return clients, nil
+}
+
+// drawSeats runs the seat draw of the room and announces the result on the start streams,
+// it has to run before the game engine sends gameInitInfo. A fixed seed is offset by the
+// number of matches played so rematches don't repeat the first draw
+func (s *MahjongServer) drawSeats(r *room.Room, mode room.SeatDraw) error {
+	if mode == room.SeatDrawNone {
+		return nil
+	}
+	var seed int64
+	var err error
+	if r.SeatDrawSeed != nil {
+		seed = *r.SeatDrawSeed + int64(len(r.SessionTable().Matches))
+	} else if seed, err = room.NewSeatDrawSeed(); err != nil {
+		return err
+	}
+	result, err := r.DrawSeats(mode, seed)
+	if err != nil {
+		return err
+	}
+	draw := &pb.SeatDrawReply{
+		Mode: pb.SeatDrawMode(mode),
+		Seed: seed,
+	}
+	message := fmt.Sprintf("seat draw of room: %s", r.RoomName)
+	if mode == room.SeatDrawWind {
+		message += fmt.Sprintf(", the temporary east rolled %d and %d", result.Dice[0], result.Dice[1])
+		draw.Dice = []int32{int32(result.Dice[0]), int32(result.Dice[1])}
+	}
+	for _, a := range result.Seats {
+		draw.Seats = append(draw.Seats, &pb.SeatAssignment{
+			PlayerName: a.Player.PlayerName,
+			FromSeat:   int32(a.From),
+			Seat:       int32(a.Seat),
+			Wind:       pb.Wind(a.Tile),
+			Order:      int32(a.Order),
+		})
+	}
+	err = s.startBoardCast(r, &pb.StartReply{
+		Message: message,
+		Reply:   &pb.StartReply_SeatDraw{SeatDraw: draw},
+	})
+	if err != nil {
+		return err
+	}
+	fields := log.Fields{
+		logging.EventKey: "SeatDraw",
+		"Mode":           draw.Mode.String(),
+		"Seed":           seed,
+	}
+	if mode == room.SeatDrawWind {
+		fields["Dice"] = result.Dice
+	}
+	roomLog(serverLog(), r).WithFields(fields).Info("seat draw success")
+	return nil
+}
@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// readyRequestStates lists the room states a ready request is allowed in
+func readyRequestStates(in *pb.ReadyRequest) []room.State {
+	switch in.GetRequest().(type) {
+	case *pb.ReadyRequest_Chat:
+		return []room.State{room.StateWaiting, room.StateStarting, room.StatePlaying, room.StateFinished}
+	case *pb.ReadyRequest_LeaveRoom, *pb.ReadyRequest_TransferOwner:
+		return []room.State{room.StateWaiting, room.StateFinished}
+	case *pb.ReadyRequest_Rematch:
+		return []room.State{room.StateFinished}
+	default:
+		return []room.State{room.StateWaiting}
+	}
+}
+
+// startRequestStates lists the room states a start request is allowed in, nil allows it outside a room
+func startRequestStates(in *pb.StartRequest) []room.State {
+	switch in.GetRequest().(type) {
+	case *pb.StartRequest_Ping:
+		return nil
+	case *pb.StartRequest_Chat:
+		return []room.State{room.StateStarting, room.StatePlaying, room.StateFinished}
+	default:
+		return []room.State{room.StatePlaying}
+	}
+}
+
+// checkRoomState returns a message explaining why the request is illegal in the room state of the client
+func (s *MahjongServer) checkRoomState(c *client, req proto.Message, allowed []room.State) (string, bool) {
+	if allowed == nil {
+		return "", true
+	}
+	r, err := s.getRoomByClient(c)
+	if err == nil {
+		err = r.CheckState(allowed...)
+	}
+	if err != nil {
+		return fmt.Sprintf("player: %s, %s rejected: %v", c.p.PlayerName, requestName(req), err), false
+	}
+	return "", true
+}
+
+// requestName is the name of the oneof field set in a stream request
+func requestName(req proto.Message) string {
+	m := req.ProtoReflect()
+	oneof := m.Descriptor().Oneofs().ByName("request")
+	if oneof == nil {
+		return string(m.Descriptor().Name())
+	}
+	if fd := m.WhichOneof(oneof); fd != nil {
+		return string(fd.Name())
+	}
+	return "empty request"
+}
+
+func newPbRoomStatus(state room.State) pb.RoomStatus {
+	return pb.RoomStatus(state)
+}
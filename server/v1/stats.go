@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"context"
+	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+)
+
+func (s *MahjongServer) GetPlayerStats(ctx context.Context, in *pb.GetPlayerStatsRequest) (*pb.GetPlayerStatsReply, error) {
+	c, err := clientFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name := c.p.PlayerName
+	if in.PlayerName != nil && *in.PlayerName != "" {
+		name = *in.PlayerName
+	}
+	p, err := s.store.GetProfile(name)
+	if err != nil {
+		return nil, err
+	}
+	state := p.Rating(s.rating)
+	a := p.Aggregate
+	return &pb.GetPlayerStatsReply{
+		Message: "get player stats success",
+		Stats: &pb.PlayerStats{
+			PlayerName:       p.Name,
+			RatingSystem:     s.rating.Name(),
+			Rank:             s.rating.Rank(state),
+			Rating:           state.Rating,
+			RankPoints:       int32(state.Points),
+			MatchCount:       int32(a.Matches),
+			HandCount:        int32(a.Hands),
+			WinRate:          a.WinRate(),
+			DealInRate:       a.DealInRate(),
+			RiichiRate:       a.RiichiRate(),
+			CallRate:         a.CallRate(),
+			AveragePlacement: a.AveragePlacement(),
+			AverageWinPoints: a.AverageWinPoints(),
+		},
+	}, nil
+}
@@ -0,0 +1,458 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	"github.com/hphphp123321/mahjong-goserver/player"
+	"github.com/hphphp123321/mahjong-goserver/robots"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	"github.com/hphphp123321/mahjong-goserver/tournament"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (a *AdminServer) CreateTournament(ctx context.Context, in *pb.CreateTournamentRequest) (*pb.TournamentReply, error) {
+	s := a.s
+	t, err := tournament.New(in.Name, tournament.Pairing(in.Pairing), int(in.Rounds))
+	if err != nil {
+		return nil, err
+	}
+	t.RuleSet = in.RuleSet
+	preset, err := s.ruleSetPreset(in.RuleSet)
+	if err != nil {
+		return nil, err
+	}
+	if preset != nil {
+		t.Scoring = preset.Scoring
+	}
+	if in.RobotLevel != "" {
+		if _, err = robots.GetRobot(in.RobotLevel); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown robot level: %s", in.RobotLevel)
+		}
+		t.RobotLevel = in.RobotLevel
+	}
+	if in.Scoring != nil {
+		if t.Scoring, err = room.NewScoring(int(in.Scoring.StartPoints), int(in.Scoring.ReturnPoints), in.Scoring.Uma); err != nil {
+			return nil, err
+		}
+	}
+	s.tournamentMu.Lock()
+	s.tournaments[t.ID] = t
+	s.tournamentMu.Unlock()
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey: "CreateTournament",
+		"Tournament":     t.Name,
+		"Pairing":        t.Pairing.String(),
+		"Rounds":         t.Rounds,
+	}).Info("tournament created")
+	return &pb.TournamentReply{
+		Message:    fmt.Sprintf("tournament: %s, created", t.Name),
+		Tournament: newPbTournament(t, true),
+	}, nil
+}
+
+func (s *MahjongServer) ListTournaments(ctx context.Context, in *pb.Empty) (*pb.ListTournamentsReply, error) {
+	s.tournamentMu.RLock()
+	defer s.tournamentMu.RUnlock()
+	reply := &pb.ListTournamentsReply{Message: "list tournaments success"}
+	for _, t := range s.tournaments {
+		reply.Tournaments = append(reply.Tournaments, newPbTournament(t, false))
+	}
+	return reply, nil
+}
+
+func (s *MahjongServer) GetTournament(ctx context.Context, in *pb.TournamentRequest) (*pb.TournamentReply, error) {
+	t, err := s.getTournament(in.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TournamentReply{
+		Message:    "get tournament success",
+		Tournament: newPbTournament(t, true),
+	}, nil
+}
+
+// RegisterTournament adds the player to a registering tournament, seeded by the current rating
+func (s *MahjongServer) RegisterTournament(ctx context.Context, in *pb.TournamentRequest) (*pb.TournamentReply, error) {
+	c, err := clientFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.getTournament(in.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := s.store.GetProfile(c.p.PlayerName)
+	if err != nil {
+		return nil, err
+	}
+	if err = t.Register(c.p.PlayerName, s.rating.Value(profile.Rating(s.rating))); err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey: "RegisterTournament",
+		"Tournament":     t.Name,
+	}).Info("player registered")
+	return &pb.TournamentReply{
+		Message:    fmt.Sprintf("player: %s, registered to tournament: %s", c.p.PlayerName, t.Name),
+		Tournament: newPbTournament(t, false),
+	}, nil
+}
+
+// LeaveTournament unregisters the player, a running tournament drops the player from the next rounds
+func (s *MahjongServer) LeaveTournament(ctx context.Context, in *pb.TournamentRequest) (*pb.TournamentReply, error) {
+	c, err := clientFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.getTournament(in.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+	if err = t.Leave(c.p.PlayerName); err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey: "LeaveTournament",
+		"Tournament":     t.Name,
+	}).Info("player left tournament")
+	return &pb.TournamentReply{
+		Message:    fmt.Sprintf("player: %s, left tournament: %s", c.p.PlayerName, t.Name),
+		Tournament: newPbTournament(t, false),
+	}, nil
+}
+
+func (a *AdminServer) StartTournament(ctx context.Context, in *pb.TournamentRequest) (*pb.TournamentReply, error) {
+	t, err := a.s.getTournament(in.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := t.Start()
+	if err != nil {
+		return nil, err
+	}
+	a.s.seatTables(t, tables)
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey: "StartTournament",
+		"Tournament":     t.Name,
+		"Round":          t.Round(),
+	}).Info("tournament started")
+	return &pb.TournamentReply{
+		Message:    fmt.Sprintf("tournament: %s, started", t.Name),
+		Tournament: newPbTournament(t, true),
+	}, nil
+}
+
+func (a *AdminServer) PauseTournament(ctx context.Context, in *pb.TournamentRequest) (*pb.TournamentReply, error) {
+	t, err := a.s.getTournament(in.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+	if err = t.Pause(); err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey: "PauseTournament",
+		"Tournament":     t.Name,
+	}).Info("tournament paused")
+	return &pb.TournamentReply{
+		Message:    fmt.Sprintf("tournament: %s, paused", t.Name),
+		Tournament: newPbTournament(t, true),
+	}, nil
+}
+
+// RepairTournament pairs the current round again, only while no table of the round left the waiting state
+func (a *AdminServer) RepairTournament(ctx context.Context, in *pb.TournamentRequest) (*pb.TournamentReply, error) {
+	s := a.s
+	t, err := s.getTournament(in.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+	for _, table := range t.Tables() {
+		s.roomMu.RLock()
+		r, ok := s.rooms[table.RoomID]
+		s.roomMu.RUnlock()
+		if ok && r.State() != room.StateWaiting {
+			return nil, errTablePlaying
+		}
+	}
+	old, tables, err := t.Repair(time.Now().UnixNano())
+	if err != nil {
+		return nil, err
+	}
+	for _, table := range old {
+		s.roomMu.RLock()
+		r, ok := s.rooms[table.RoomID]
+		s.roomMu.RUnlock()
+		if ok {
+			s.closeRoom(r, fmt.Sprintf("tournament: %s, round %d is paired again", t.Name, table.Round))
+		}
+	}
+	s.seatTables(t, tables)
+	logging.FromContext(ctx).WithFields(log.Fields{
+		logging.EventKey: "RepairTournament",
+		"Tournament":     t.Name,
+		"Round":          t.Round(),
+	}).Info("tournament round paired again")
+	return &pb.TournamentReply{
+		Message:    fmt.Sprintf("tournament: %s, round %d paired again", t.Name, t.Round()),
+		Tournament: newPbTournament(t, true),
+	}, nil
+}
+
+func (s *MahjongServer) getTournament(tournamentID string) (*tournament.Tournament, error) {
+	id, err := uuid.Parse(tournamentID)
+	if err != nil {
+		return nil, tournament.ErrNotFound
+	}
+	s.tournamentMu.RLock()
+	defer s.tournamentMu.RUnlock()
+	t, ok := s.tournaments[id]
+	if !ok {
+		return nil, tournament.ErrNotFound
+	}
+	return t, nil
+}
+
+func (s *MahjongServer) tournamentByRoom(roomID uuid.UUID) *tournament.Tournament {
+	s.tournamentMu.RLock()
+	defer s.tournamentMu.RUnlock()
+	for _, t := range s.tournaments {
+		if t.HasRoom(roomID) {
+			return t
+		}
+	}
+	return nil
+}
+
+// recordTournamentTable passes the result of a tournament room to its tournament,
+// closes the room and seats the next round once the result finished the round
+func (s *MahjongServer) recordTournamentTable(r *room.Room, results []room.SessionResult) {
+	t := s.tournamentByRoom(r.RoomID)
+	if t == nil {
+		return
+	}
+	tables, err := t.Record(r.RoomID, results)
+	if err != nil {
+		roomLog(serverLog(), r).WithField("Tournament", t.Name).Warningf("record table failed: %v", err)
+		return
+	}
+	s.closeRoom(r, fmt.Sprintf("table: %s, of tournament: %s, is done", r.RoomName, t.Name))
+	s.seatTables(t, tables)
+	if t.Status() == tournament.StatusFinished {
+		serverLog().WithFields(log.Fields{
+			logging.EventKey: "FinishTournament",
+			"Tournament":     t.Name,
+		}).Info("tournament finished")
+	}
+}
+
+// seatTables creates the rooms of the tables, tables without any online player
+// are recorded without results and may pair the next round
+func (s *MahjongServer) seatTables(t *tournament.Tournament, tables []*tournament.Table) {
+	for len(tables) > 0 {
+		table := tables[0]
+		tables = tables[1:]
+		next, err := s.seatTable(t, table)
+		if err != nil {
+			serverLog().WithFields(log.Fields{
+				"Tournament": t.Name,
+				"Table":      table.Number,
+			}).Warningf("seat table failed: %v", err)
+			continue
+		}
+		tables = append(tables, next...)
+	}
+}
+
+// seatTable creates the private room of the table and seats the online players that are not in
+// another room, robots take the seats of missing players so the table can still be played.
+// The table is forfeited when none of its players is online or its room can't be set up
+func (s *MahjongServer) seatTable(t *tournament.Tournament, table *tournament.Table) ([]*tournament.Table, error) {
+	var seated [4]*client
+	var owner *player.Player
+	s.clientMu.RLock()
+	for seat, name := range table.Players {
+		if name == "" {
+			continue
+		}
+		for _, c := range s.clients {
+			if c.p.PlayerName == name && c.claimSeat() {
+				seated[seat] = c
+				if owner == nil {
+					owner = c.p
+				}
+				break
+			}
+		}
+	}
+	s.clientMu.RUnlock()
+	defer func() {
+		for _, c := range seated {
+			if c != nil {
+				c.releaseSeat()
+			}
+		}
+	}()
+
+	roomID := uuid.New()
+	if owner == nil {
+		serverLog().WithFields(log.Fields{
+			"Tournament": t.Name,
+			"Table":      table.Number,
+		}).Warning("no player of the table is online")
+		return s.forfeitTable(t, table, roomID)
+	}
+	r, err := s.newTableRoom(t, table, roomID, owner, seated)
+	if err != nil {
+		serverLog().WithFields(log.Fields{
+			"Tournament": t.Name,
+			"Table":      table.Number,
+		}).Warningf("set up table failed, the table is forfeited: %v", err)
+		return s.forfeitTable(t, table, roomID)
+	}
+	if err = t.SetRoom(table.Round, table.Number, roomID); err != nil {
+		return nil, err
+	}
+	s.roomMu.Lock()
+	s.rooms[roomID] = r
+	s.roomMu.Unlock()
+	s.lobby.add(r)
+	for _, c := range seated {
+		if c != nil {
+			// the tournament seat wins over a matchmaking ticket
+			_ = s.queue.Leave(c.p.Token)
+			c.enterRoom(roomID)
+		}
+	}
+	roomLog(serverLog(), r).WithFields(log.Fields{
+		logging.EventKey: "SeatTable",
+		"Tournament":     t.Name,
+		"Robots":         4 - r.HumanCount(),
+	}).Info("tournament table seated")
+	return nil, nil
+}
+
+// newTableRoom sets up the room of a table without putting it into the server,
+// the seats of the players are put back if it fails
+func (s *MahjongServer) newTableRoom(t *tournament.Tournament, table *tournament.Table, roomID uuid.UUID, owner *player.Player, seated [4]*client) (r *room.Room, err error) {
+	var seats [4]int
+	for i, c := range seated {
+		if c != nil {
+			seats[i] = c.p.Seat
+		}
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		for i, c := range seated {
+			if c != nil {
+				c.p.Seat = seats[i]
+			}
+		}
+	}()
+	r = room.NewRoom(roomID, fmt.Sprintf("%s R%d-T%d", t.Name, table.Round, table.Number), owner)
+	r.RuleSet = t.RuleSet
+	r.Private = true
+	r.SeatDraw = room.SeatDrawWind
+	r.Session.Scoring = t.Scoring
+	for seat, c := range seated {
+		if c != nil {
+			if err = r.AddPlayer(c.p); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if table.Players[seat] != "" {
+			roomLog(serverLog(), r).WithFields(log.Fields{
+				"Tournament":          t.Name,
+				logging.PlayerNameKey: table.Players[seat],
+			}).Warning("player is not available, a robot takes the seat")
+		}
+		agent, err := robots.GetRobot(t.RobotLevel)
+		if err != nil {
+			return nil, err
+		}
+		if err = r.AddRobot(player.NewRobot(t.RobotLevel, r.IdleSeats[0], agent)); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// forfeitTable records the table as played without results, no player of it gets points
+func (s *MahjongServer) forfeitTable(t *tournament.Tournament, table *tournament.Table, roomID uuid.UUID) ([]*tournament.Table, error) {
+	if err := t.SetRoom(table.Round, table.Number, roomID); err != nil {
+		return nil, err
+	}
+	return t.Record(roomID, nil)
+}
+
+// closeRoom detaches the human players of the room and removes it
+func (s *MahjongServer) closeRoom(r *room.Room, msg string) {
+	rep := &pb.ReadyReply{Message: msg}
+	for _, p := range r.Players {
+		if !p.IsRobot() {
+			s.detachPlayer(p.Token, rep)
+		}
+	}
+	if err := r.Transition(room.StateClosed); err != nil {
+		roomLog(serverLog(), r).Warningf("close room failed: %v", err)
+	}
+	s.roomMu.Lock()
+	delete(s.rooms, r.RoomID)
+	s.roomMu.Unlock()
+	s.lobby.remove(r)
+	logging.ReleaseRoom(r.RoomID)
+}
+
+func newPbTournament(t *tournament.Tournament, full bool) *pb.Tournament {
+	pt := &pb.Tournament{
+		TournamentID: t.ID.String(),
+		Name:         t.Name,
+		Status:       pb.TournamentStatus(t.Status()),
+		Pairing:      pb.TournamentPairing(t.Pairing),
+		Round:        int32(t.Round()),
+		Rounds:       int32(t.Rounds),
+		PlayerCount:  int32(t.PlayerCount()),
+		RuleSet:      t.RuleSet,
+		Scoring: &pb.SessionScoring{
+			StartPoints:  int32(t.Scoring.StartPoints),
+			ReturnPoints: int32(t.Scoring.ReturnPoints),
+			Uma:          t.Scoring.Uma[:],
+		},
+	}
+	if !full {
+		return pt
+	}
+	for _, st := range t.Standings() {
+		standing := &pb.TournamentStanding{
+			Rank:       int32(st.Rank),
+			PlayerName: st.PlayerName,
+			Points:     st.Points,
+			TotalScore: int64(st.Score),
+			MatchCount: int32(st.Matches),
+			Dropped:    st.Dropped,
+		}
+		for _, n := range st.Placements {
+			standing.Placements = append(standing.Placements, int32(n))
+		}
+		pt.Standings = append(pt.Standings, standing)
+	}
+	for _, table := range t.Tables() {
+		pt.Tables = append(pt.Tables, &pb.TournamentTable{
+			Round:   int32(table.Round),
+			Table:   int32(table.Number),
+			RoomID:  table.RoomID.String(),
+			Players: table.Players[:],
+			Done:    table.Done,
+		})
+	}
+	return pt
+}
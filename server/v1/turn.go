@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/hphphp123321/mahjong-goserver/logging"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	"github.com/hphphp123321/mahjong-goserver/tracing"
+	log "github.com/sirupsen/logrus"
+)
+
+// StartHand is called by the game engine when a hand of a playing room begins,
+// the turn spans and log lines of the room carry the hand until the next one begins
+func (s *MahjongServer) StartHand(roomID uuid.UUID, hand int) error {
+	r := s.engineRoom(roomID)
+	if r == nil {
+		return errRoomNotFound
+	}
+	if err := r.StartHand(hand); err != nil {
+		return err
+	}
+	roomLog(serverLog(), r).WithFields(log.Fields{
+		logging.EventKey: "StartHand",
+	}).Debug("hand started")
+	return nil
+}
+
+// TraceTurn is called by the game engine around a step of a turn, name is tracing.SpanDraw,
+// tracing.SpanDecisionWait or tracing.SpanCallArbitration and seat is -1 for a step of the whole table.
+// The span carries the room and its current hand, the returned function ends it with the error of the step
+func (s *MahjongServer) TraceTurn(ctx context.Context, roomID uuid.UUID, name string, seat int) (context.Context, func(error)) {
+	turn := tracing.Turn{RoomID: roomID, Hand: -1, Seat: seat}
+	if r := s.engineRoom(roomID); r != nil && r.Hand() > 0 {
+		turn.Hand = r.Hand()
+	}
+	ctx, span := tracing.StartTurn(ctx, name, turn)
+	return ctx, func(err error) {
+		tracing.End(span, err)
+	}
+}
+
+// engineRoom returns the room the game engine reports about, nil if it is gone
+func (s *MahjongServer) engineRoom(roomID uuid.UUID) *room.Room {
+	s.roomMu.RLock()
+	defer s.roomMu.RUnlock()
+	return s.rooms[roomID]
+}
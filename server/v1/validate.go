@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"github.com/google/uuid"
+	pb "github.com/hphphp123321/mahjong-goserver/services/mahjong/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validate checks the fields of a request, the returned error has codes.InvalidArgument
+func validate(req interface{}) error {
+	switch in := req.(type) {
+	case *pb.RegisterRequest:
+		if in.PlayerName == "" || in.Password == "" {
+			return errMissingPassword
+		}
+	case *pb.LoginRequest:
+		if in.PlayerName == "" {
+			return status.Error(codes.InvalidArgument, "player name is required")
+		}
+	case *pb.CreateRoomRequest:
+		if in.RoomName == "" {
+			return status.Error(codes.InvalidArgument, "room name is required")
+		}
+		if in.Scoring != nil && len(in.Scoring.Uma) != 4 {
+			return status.Error(codes.InvalidArgument, "scoring needs 4 uma values")
+		}
+	case *pb.CreateTournamentRequest:
+		if in.Name == "" {
+			return status.Error(codes.InvalidArgument, "tournament name is required")
+		}
+		if in.Scoring != nil && len(in.Scoring.Uma) != 4 {
+			return status.Error(codes.InvalidArgument, "scoring needs 4 uma values")
+		}
+	case *pb.TournamentRequest:
+		if _, err := uuid.Parse(in.TournamentID); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid tournament id: %s", in.TournamentID)
+		}
+	case *pb.AdminKickRequest:
+		if in.PlayerName == "" {
+			return status.Error(codes.InvalidArgument, "player name is required")
+		}
+	case *pb.AdminPlayerRequest:
+		if in.PlayerName == "" {
+			return status.Error(codes.InvalidArgument, "player name is required")
+		}
+	case *pb.AdminRoomRequest:
+		if _, err := uuid.Parse(in.RoomID); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid room id: %s", in.RoomID)
+		}
+	case *pb.ForceEndGameRequest:
+		if _, err := uuid.Parse(in.RoomID); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid room id: %s", in.RoomID)
+		}
+		if len(in.Scores) != 0 && len(in.Scores) != 4 {
+			return status.Error(codes.InvalidArgument, "scores need one value for every seat")
+		}
+	case *pb.AnnounceRequest:
+		if in.Message == "" {
+			return status.Error(codes.InvalidArgument, "message is required")
+		}
+	case *pb.JoinRoomRequest:
+		if in.GetInviteCode() != "" && in.RoomID == "" {
+			return nil
+		}
+		if _, err := uuid.Parse(in.RoomID); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid room id: %s", in.RoomID)
+		}
+	}
+	return nil
+}
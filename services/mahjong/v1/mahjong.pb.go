@@ -20,6 +20,110 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ProtocolSupport int32
+
+const (
+	ProtocolSupport_ProtocolSupported   ProtocolSupport = 0
+	ProtocolSupport_ProtocolDeprecated  ProtocolSupport = 1 // still served, the client should be updated
+	ProtocolSupport_ProtocolUnsupported ProtocolSupport = 2
+)
+
+// Enum value maps for ProtocolSupport.
+var (
+	ProtocolSupport_name = map[int32]string{
+		0: "ProtocolSupported",
+		1: "ProtocolDeprecated",
+		2: "ProtocolUnsupported",
+	}
+	ProtocolSupport_value = map[string]int32{
+		"ProtocolSupported":   0,
+		"ProtocolDeprecated":  1,
+		"ProtocolUnsupported": 2,
+	}
+)
+
+func (x ProtocolSupport) Enum() *ProtocolSupport {
+	p := new(ProtocolSupport)
+	*p = x
+	return p
+}
+
+func (x ProtocolSupport) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProtocolSupport) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_mahjong_v1_mahjong_proto_enumTypes[0].Descriptor()
+}
+
+func (ProtocolSupport) Type() protoreflect.EnumType {
+	return &file_services_mahjong_v1_mahjong_proto_enumTypes[0]
+}
+
+func (x ProtocolSupport) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProtocolSupport.Descriptor instead.
+func (ProtocolSupport) EnumDescriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{0}
+}
+
+type RoomStatus int32
+
+const (
+	RoomStatus_RoomWaiting  RoomStatus = 0 // players join, leave and get ready
+	RoomStatus_RoomStarting RoomStatus = 1 // the game is being set up
+	RoomStatus_RoomPlaying  RoomStatus = 2
+	RoomStatus_RoomFinished RoomStatus = 3 // the match ended
+	RoomStatus_RoomClosed   RoomStatus = 4
+)
+
+// Enum value maps for RoomStatus.
+var (
+	RoomStatus_name = map[int32]string{
+		0: "RoomWaiting",
+		1: "RoomStarting",
+		2: "RoomPlaying",
+		3: "RoomFinished",
+		4: "RoomClosed",
+	}
+	RoomStatus_value = map[string]int32{
+		"RoomWaiting":  0,
+		"RoomStarting": 1,
+		"RoomPlaying":  2,
+		"RoomFinished": 3,
+		"RoomClosed":   4,
+	}
+)
+
+func (x RoomStatus) Enum() *RoomStatus {
+	p := new(RoomStatus)
+	*p = x
+	return p
+}
+
+func (x RoomStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoomStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_mahjong_v1_mahjong_proto_enumTypes[1].Descriptor()
+}
+
+func (RoomStatus) Type() protoreflect.EnumType {
+	return &file_services_mahjong_v1_mahjong_proto_enumTypes[1]
+}
+
+func (x RoomStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoomStatus.Descriptor instead.
+func (RoomStatus) EnumDescriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{1}
+}
+
 type ActionType int32
 
 const (
@@ -80,11 +184,11 @@ func (x ActionType) String() string {
 }
 
 func (ActionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_services_mahjong_v1_mahjong_proto_enumTypes[0].Descriptor()
+	return file_services_mahjong_v1_mahjong_proto_enumTypes[2].Descriptor()
 }
 
 func (ActionType) Type() protoreflect.EnumType {
-	return &file_services_mahjong_v1_mahjong_proto_enumTypes[0]
+	return &file_services_mahjong_v1_mahjong_proto_enumTypes[2]
 }
 
 func (x ActionType) Number() protoreflect.EnumNumber {
@@ -93,7 +197,7 @@ func (x ActionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ActionType.Descriptor instead.
 func (ActionType) EnumDescriptor() ([]byte, []int) {
-	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{0}
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{2}
 }
 
 type Wind int32
@@ -132,11 +236,11 @@ func (x Wind) String() string {
 }
 
 func (Wind) Descriptor() protoreflect.EnumDescriptor {
-	return file_services_mahjong_v1_mahjong_proto_enumTypes[1].Descriptor()
+	return file_services_mahjong_v1_mahjong_proto_enumTypes[3].Descriptor()
 }
 
 func (Wind) Type() protoreflect.EnumType {
-	return &file_services_mahjong_v1_mahjong_proto_enumTypes[1]
+	return &file_services_mahjong_v1_mahjong_proto_enumTypes[3]
 }
 
 func (x Wind) Number() protoreflect.EnumNumber {
@@ -145,7 +249,304 @@ func (x Wind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Wind.Descriptor instead.
 func (Wind) EnumDescriptor() ([]byte, []int) {
-	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{1}
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{3}
+}
+
+type LeaderboardOrder int32
+
+const (
+	LeaderboardOrder_ByRating           LeaderboardOrder = 0
+	LeaderboardOrder_ByAveragePlacement LeaderboardOrder = 1 // lower is better
+	LeaderboardOrder_ByTotalPoints      LeaderboardOrder = 2
+)
+
+// Enum value maps for LeaderboardOrder.
+var (
+	LeaderboardOrder_name = map[int32]string{
+		0: "ByRating",
+		1: "ByAveragePlacement",
+		2: "ByTotalPoints",
+	}
+	LeaderboardOrder_value = map[string]int32{
+		"ByRating":           0,
+		"ByAveragePlacement": 1,
+		"ByTotalPoints":      2,
+	}
+)
+
+func (x LeaderboardOrder) Enum() *LeaderboardOrder {
+	p := new(LeaderboardOrder)
+	*p = x
+	return p
+}
+
+func (x LeaderboardOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_mahjong_v1_mahjong_proto_enumTypes[4].Descriptor()
+}
+
+func (LeaderboardOrder) Type() protoreflect.EnumType {
+	return &file_services_mahjong_v1_mahjong_proto_enumTypes[4]
+}
+
+func (x LeaderboardOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardOrder.Descriptor instead.
+func (LeaderboardOrder) EnumDescriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{4}
+}
+
+type TimeWindow int32
+
+const (
+	TimeWindow_AllTime TimeWindow = 0
+	TimeWindow_Week    TimeWindow = 1 // last 7 days
+	TimeWindow_Month   TimeWindow = 2 // last 30 days
+)
+
+// Enum value maps for TimeWindow.
+var (
+	TimeWindow_name = map[int32]string{
+		0: "AllTime",
+		1: "Week",
+		2: "Month",
+	}
+	TimeWindow_value = map[string]int32{
+		"AllTime": 0,
+		"Week":    1,
+		"Month":   2,
+	}
+)
+
+func (x TimeWindow) Enum() *TimeWindow {
+	p := new(TimeWindow)
+	*p = x
+	return p
+}
+
+func (x TimeWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_mahjong_v1_mahjong_proto_enumTypes[5].Descriptor()
+}
+
+func (TimeWindow) Type() protoreflect.EnumType {
+	return &file_services_mahjong_v1_mahjong_proto_enumTypes[5]
+}
+
+func (x TimeWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeWindow.Descriptor instead.
+func (TimeWindow) EnumDescriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{5}
+}
+
+type SeatDrawMode int32
+
+const (
+	SeatDrawMode_NoDraw     SeatDrawMode = 0 // keep the seats players joined in
+	SeatDrawMode_RandomDraw SeatDrawMode = 1 // shuffle the seats
+	SeatDrawMode_WindDraw   SeatDrawMode = 2 // every player draws one of the four wind tiles (kaze-gime)
+	SeatDrawMode_RotateDraw SeatDrawMode = 3 // every player moves one seat, the south player becomes east
+)
+
+// Enum value maps for SeatDrawMode.
+var (
+	SeatDrawMode_name = map[int32]string{
+		0: "NoDraw",
+		1: "RandomDraw",
+		2: "WindDraw",
+		3: "RotateDraw",
+	}
+	SeatDrawMode_value = map[string]int32{
+		"NoDraw":     0,
+		"RandomDraw": 1,
+		"WindDraw":   2,
+		"RotateDraw": 3,
+	}
+)
+
+func (x SeatDrawMode) Enum() *SeatDrawMode {
+	p := new(SeatDrawMode)
+	*p = x
+	return p
+}
+
+func (x SeatDrawMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeatDrawMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_mahjong_v1_mahjong_proto_enumTypes[6].Descriptor()
+}
+
+func (SeatDrawMode) Type() protoreflect.EnumType {
+	return &file_services_mahjong_v1_mahjong_proto_enumTypes[6]
+}
+
+func (x SeatDrawMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeatDrawMode.Descriptor instead.
+func (SeatDrawMode) EnumDescriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{6}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_NoExport ExportFormat = 0
+	ExportFormat_CSV      ExportFormat = 1 // the standings table
+	ExportFormat_JSON     ExportFormat = 2 // the whole summary
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "NoExport",
+		1: "CSV",
+		2: "JSON",
+	}
+	ExportFormat_value = map[string]int32{
+		"NoExport": 0,
+		"CSV":      1,
+		"JSON":     2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_mahjong_v1_mahjong_proto_enumTypes[7].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_services_mahjong_v1_mahjong_proto_enumTypes[7]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{7}
+}
+
+type TournamentPairing int32
+
+const (
+	TournamentPairing_SwissPairing  TournamentPairing = 0 // close standings play together and avoid rematches
+	TournamentPairing_SeededPairing TournamentPairing = 1 // every table gets a strong and a weak player
+)
+
+// Enum value maps for TournamentPairing.
+var (
+	TournamentPairing_name = map[int32]string{
+		0: "SwissPairing",
+		1: "SeededPairing",
+	}
+	TournamentPairing_value = map[string]int32{
+		"SwissPairing":  0,
+		"SeededPairing": 1,
+	}
+)
+
+func (x TournamentPairing) Enum() *TournamentPairing {
+	p := new(TournamentPairing)
+	*p = x
+	return p
+}
+
+func (x TournamentPairing) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentPairing) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_mahjong_v1_mahjong_proto_enumTypes[8].Descriptor()
+}
+
+func (TournamentPairing) Type() protoreflect.EnumType {
+	return &file_services_mahjong_v1_mahjong_proto_enumTypes[8]
+}
+
+func (x TournamentPairing) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentPairing.Descriptor instead.
+func (TournamentPairing) EnumDescriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{8}
+}
+
+type TournamentStatus int32
+
+const (
+	TournamentStatus_TournamentRegistering TournamentStatus = 0
+	TournamentStatus_TournamentRunning     TournamentStatus = 1
+	TournamentStatus_TournamentPaused      TournamentStatus = 2
+	TournamentStatus_TournamentFinished    TournamentStatus = 3
+)
+
+// Enum value maps for TournamentStatus.
+var (
+	TournamentStatus_name = map[int32]string{
+		0: "TournamentRegistering",
+		1: "TournamentRunning",
+		2: "TournamentPaused",
+		3: "TournamentFinished",
+	}
+	TournamentStatus_value = map[string]int32{
+		"TournamentRegistering": 0,
+		"TournamentRunning":     1,
+		"TournamentPaused":      2,
+		"TournamentFinished":    3,
+	}
+)
+
+func (x TournamentStatus) Enum() *TournamentStatus {
+	p := new(TournamentStatus)
+	*p = x
+	return p
+}
+
+func (x TournamentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_mahjong_v1_mahjong_proto_enumTypes[9].Descriptor()
+}
+
+func (TournamentStatus) Type() protoreflect.EnumType {
+	return &file_services_mahjong_v1_mahjong_proto_enumTypes[9]
+}
+
+func (x TournamentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentStatus.Descriptor instead.
+func (TournamentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{9}
 }
 
 type Empty struct {
@@ -186,16 +587,18 @@ func (*Empty) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{0}
 }
 
-type LoginRequest struct {
+type HelloRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerName string `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	ProtocolVersion string   `protobuf:"bytes,1,opt,name=protocolVersion,proto3" json:"protocolVersion,omitempty"` // like 1.2
+	ClientName      string   `protobuf:"bytes,2,opt,name=clientName,proto3" json:"clientName,omitempty"`
+	Features        []string `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"` // reconnect, sanma or spectate
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
+func (x *HelloRequest) Reset() {
+	*x = HelloRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -203,13 +606,13 @@ func (x *LoginRequest) Reset() {
 	}
 }
 
-func (x *LoginRequest) String() string {
+func (x *HelloRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (*HelloRequest) ProtoMessage() {}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+func (x *HelloRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -221,30 +624,44 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HelloRequest.ProtoReflect.Descriptor instead.
+func (*HelloRequest) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *LoginRequest) GetPlayerName() string {
+func (x *HelloRequest) GetProtocolVersion() string {
 	if x != nil {
-		return x.PlayerName
+		return x.ProtocolVersion
 	}
 	return ""
 }
 
-type LoginReply struct {
+func (x *HelloRequest) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *HelloRequest) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type ProtocolRelease struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message       string         `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Token         string         `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	ReconnectInfo *ReconnectInfo `protobuf:"bytes,3,opt,name=reconnectInfo,proto3,oneof" json:"reconnectInfo,omitempty"`
+	Version string          `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Support ProtocolSupport `protobuf:"varint,2,opt,name=support,proto3,enum=mahjong.ProtocolSupport" json:"support,omitempty"`
+	Note    string          `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
 }
 
-func (x *LoginReply) Reset() {
-	*x = LoginReply{}
+func (x *ProtocolRelease) Reset() {
+	*x = ProtocolRelease{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -252,13 +669,13 @@ func (x *LoginReply) Reset() {
 	}
 }
 
-func (x *LoginReply) String() string {
+func (x *ProtocolRelease) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginReply) ProtoMessage() {}
+func (*ProtocolRelease) ProtoMessage() {}
 
-func (x *LoginReply) ProtoReflect() protoreflect.Message {
+func (x *ProtocolRelease) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -270,42 +687,48 @@ func (x *LoginReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginReply.ProtoReflect.Descriptor instead.
-func (*LoginReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProtocolRelease.ProtoReflect.Descriptor instead.
+func (*ProtocolRelease) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *LoginReply) GetMessage() string {
+func (x *ProtocolRelease) GetVersion() string {
 	if x != nil {
-		return x.Message
+		return x.Version
 	}
 	return ""
 }
 
-func (x *LoginReply) GetToken() string {
+func (x *ProtocolRelease) GetSupport() ProtocolSupport {
 	if x != nil {
-		return x.Token
+		return x.Support
 	}
-	return ""
+	return ProtocolSupport_ProtocolSupported
 }
 
-func (x *LoginReply) GetReconnectInfo() *ReconnectInfo {
+func (x *ProtocolRelease) GetNote() string {
 	if x != nil {
-		return x.ReconnectInfo
+		return x.Note
 	}
-	return nil
+	return ""
 }
 
-type LogoutReply struct {
+type HelloReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Message            string             `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ProtocolVersion    string             `protobuf:"bytes,2,opt,name=protocolVersion,proto3" json:"protocolVersion,omitempty"`               // of the server
+	MinProtocolVersion string             `protobuf:"bytes,3,opt,name=minProtocolVersion,proto3" json:"minProtocolVersion,omitempty"`         // the oldest the server accepts
+	Support            ProtocolSupport    `protobuf:"varint,4,opt,name=support,proto3,enum=mahjong.ProtocolSupport" json:"support,omitempty"` // of the protocol of the client
+	Features           []string           `protobuf:"bytes,5,rep,name=features,proto3" json:"features,omitempty"`                             // asked for by the client and available
+	MissingFeatures    []string           `protobuf:"bytes,6,rep,name=missingFeatures,proto3" json:"missingFeatures,omitempty"`               // asked for by the client but not available on this server
+	Matrix             []*ProtocolRelease `protobuf:"bytes,7,rep,name=matrix,proto3" json:"matrix,omitempty"`                                 // the protocol versions the server knows, oldest first
 }
 
-func (x *LogoutReply) Reset() {
-	*x = LogoutReply{}
+func (x *HelloReply) Reset() {
+	*x = HelloReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -313,13 +736,13 @@ func (x *LogoutReply) Reset() {
 	}
 }
 
-func (x *LogoutReply) String() string {
+func (x *HelloReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutReply) ProtoMessage() {}
+func (*HelloReply) ProtoMessage() {}
 
-func (x *LogoutReply) ProtoReflect() protoreflect.Message {
+func (x *HelloReply) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -331,31 +754,71 @@ func (x *LogoutReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutReply.ProtoReflect.Descriptor instead.
-func (*LogoutReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use HelloReply.ProtoReflect.Descriptor instead.
+func (*HelloReply) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *LogoutReply) GetMessage() string {
+func (x *HelloReply) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type ReconnectInfo struct {
+func (x *HelloReply) GetProtocolVersion() string {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return ""
+}
+
+func (x *HelloReply) GetMinProtocolVersion() string {
+	if x != nil {
+		return x.MinProtocolVersion
+	}
+	return ""
+}
+
+func (x *HelloReply) GetSupport() ProtocolSupport {
+	if x != nil {
+		return x.Support
+	}
+	return ProtocolSupport_ProtocolSupported
+}
+
+func (x *HelloReply) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *HelloReply) GetMissingFeatures() []string {
+	if x != nil {
+		return x.MissingFeatures
+	}
+	return nil
+}
+
+func (x *HelloReply) GetMatrix() []*ProtocolRelease {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+type RegisterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomID      string        `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"`
-	GameInfo    *GameInfo     `protobuf:"bytes,2,opt,name=gameInfo,proto3" json:"gameInfo,omitempty"`
-	HandTiles   []int32       `protobuf:"varint,3,rep,packed,name=handTiles,proto3" json:"handTiles,omitempty"`
-	PlayerInfos []*PlayerInfo `protobuf:"bytes,4,rep,name=playerInfos,proto3" json:"playerInfos,omitempty"`
+	PlayerName string `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Password   string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *ReconnectInfo) Reset() {
-	*x = ReconnectInfo{}
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -363,13 +826,13 @@ func (x *ReconnectInfo) Reset() {
 	}
 }
 
-func (x *ReconnectInfo) String() string {
+func (x *RegisterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReconnectInfo) ProtoMessage() {}
+func (*RegisterRequest) ProtoMessage() {}
 
-func (x *ReconnectInfo) ProtoReflect() protoreflect.Message {
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -381,54 +844,35 @@ func (x *ReconnectInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReconnectInfo.ProtoReflect.Descriptor instead.
-func (*ReconnectInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ReconnectInfo) GetRoomID() string {
+func (x *RegisterRequest) GetPlayerName() string {
 	if x != nil {
-		return x.RoomID
+		return x.PlayerName
 	}
 	return ""
 }
 
-func (x *ReconnectInfo) GetGameInfo() *GameInfo {
-	if x != nil {
-		return x.GameInfo
-	}
-	return nil
-}
-
-func (x *ReconnectInfo) GetHandTiles() []int32 {
-	if x != nil {
-		return x.HandTiles
-	}
-	return nil
-}
-
-func (x *ReconnectInfo) GetPlayerInfos() []*PlayerInfo {
+func (x *RegisterRequest) GetPassword() string {
 	if x != nil {
-		return x.PlayerInfos
+		return x.Password
 	}
-	return nil
+	return ""
 }
 
-type PlayerInfo struct {
+type RegisterReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerName   string    `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
-	PlayerWind   Wind      `protobuf:"varint,2,opt,name=playerWind,proto3,enum=mahjong.Wind" json:"playerWind,omitempty"`
-	PlayerPoints int32     `protobuf:"varint,3,opt,name=playerPoints,proto3" json:"playerPoints,omitempty"`
-	BoardTiles   []int32   `protobuf:"varint,4,rep,packed,name=boardTiles,proto3" json:"boardTiles,omitempty"`
-	Actions      []*Action `protobuf:"bytes,5,rep,name=actions,proto3" json:"actions,omitempty"`
-	IsRiichi     bool      `protobuf:"varint,6,opt,name=isRiichi,proto3" json:"isRiichi,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *PlayerInfo) Reset() {
-	*x = PlayerInfo{}
+func (x *RegisterReply) Reset() {
+	*x = RegisterReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -436,13 +880,13 @@ func (x *PlayerInfo) Reset() {
 	}
 }
 
-func (x *PlayerInfo) String() string {
+func (x *RegisterReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerInfo) ProtoMessage() {}
+func (*RegisterReply) ProtoMessage() {}
 
-func (x *PlayerInfo) ProtoReflect() protoreflect.Message {
+func (x *RegisterReply) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -454,66 +898,29 @@ func (x *PlayerInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerInfo.ProtoReflect.Descriptor instead.
-func (*PlayerInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterReply.ProtoReflect.Descriptor instead.
+func (*RegisterReply) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *PlayerInfo) GetPlayerName() string {
+func (x *RegisterReply) GetMessage() string {
 	if x != nil {
-		return x.PlayerName
+		return x.Message
 	}
 	return ""
 }
 
-func (x *PlayerInfo) GetPlayerWind() Wind {
-	if x != nil {
-		return x.PlayerWind
-	}
-	return Wind_East
-}
-
-func (x *PlayerInfo) GetPlayerPoints() int32 {
-	if x != nil {
-		return x.PlayerPoints
-	}
-	return 0
-}
-
-func (x *PlayerInfo) GetBoardTiles() []int32 {
-	if x != nil {
-		return x.BoardTiles
-	}
-	return nil
-}
-
-func (x *PlayerInfo) GetActions() []*Action {
-	if x != nil {
-		return x.Actions
-	}
-	return nil
-}
-
-func (x *PlayerInfo) GetIsRiichi() bool {
-	if x != nil {
-		return x.IsRiichi
-	}
-	return false
-}
-
-type Room struct {
+type LoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomID      string `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"`
-	RoomName    string `protobuf:"bytes,2,opt,name=roomName,proto3" json:"roomName,omitempty"`
-	PlayerCount int32  `protobuf:"varint,3,opt,name=playerCount,proto3" json:"playerCount,omitempty"`
-	OwnerName   string `protobuf:"bytes,4,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	PlayerName string `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Password   string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *Room) Reset() {
-	*x = Room{}
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -521,13 +928,13 @@ func (x *Room) Reset() {
 	}
 }
 
-func (x *Room) String() string {
+func (x *LoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Room) ProtoMessage() {}
+func (*LoginRequest) ProtoMessage() {}
 
-func (x *Room) ProtoReflect() protoreflect.Message {
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -539,49 +946,38 @@ func (x *Room) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Room.ProtoReflect.Descriptor instead.
-func (*Room) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Room) GetRoomID() string {
-	if x != nil {
-		return x.RoomID
-	}
-	return ""
-}
-
-func (x *Room) GetRoomName() string {
+func (x *LoginRequest) GetPlayerName() string {
 	if x != nil {
-		return x.RoomName
+		return x.PlayerName
 	}
 	return ""
 }
 
-func (x *Room) GetPlayerCount() int32 {
-	if x != nil {
-		return x.PlayerCount
-	}
-	return 0
-}
-
-func (x *Room) GetOwnerName() string {
+func (x *LoginRequest) GetPassword() string {
 	if x != nil {
-		return x.OwnerName
+		return x.Password
 	}
 	return ""
 }
 
-type CreateRoomRequest struct {
+type LoginReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomName string `protobuf:"bytes,1,opt,name=roomName,proto3" json:"roomName,omitempty"`
+	Message       string         `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Token         string         `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	ReconnectInfo *ReconnectInfo `protobuf:"bytes,3,opt,name=reconnectInfo,proto3,oneof" json:"reconnectInfo,omitempty"`
+	ExpiresAt     int64          `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"` // unix seconds
 }
 
-func (x *CreateRoomRequest) Reset() {
-	*x = CreateRoomRequest{}
+func (x *LoginReply) Reset() {
+	*x = LoginReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -589,13 +985,13 @@ func (x *CreateRoomRequest) Reset() {
 	}
 }
 
-func (x *CreateRoomRequest) String() string {
+func (x *LoginReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoomRequest) ProtoMessage() {}
+func (*LoginReply) ProtoMessage() {}
 
-func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+func (x *LoginReply) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -607,29 +1003,51 @@ func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
-func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoginReply.ProtoReflect.Descriptor instead.
+func (*LoginReply) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateRoomRequest) GetRoomName() string {
+func (x *LoginReply) GetMessage() string {
 	if x != nil {
-		return x.RoomName
+		return x.Message
 	}
 	return ""
 }
 
-type CreateRoomReply struct {
+func (x *LoginReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LoginReply) GetReconnectInfo() *ReconnectInfo {
+	if x != nil {
+		return x.ReconnectInfo
+	}
+	return nil
+}
+
+func (x *LoginReply) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type RefreshTokenReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Room    *Room  `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	Message   string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Token     string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"` // unix seconds
 }
 
-func (x *CreateRoomReply) Reset() {
-	*x = CreateRoomReply{}
+func (x *RefreshTokenReply) Reset() {
+	*x = RefreshTokenReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -637,13 +1055,13 @@ func (x *CreateRoomReply) Reset() {
 	}
 }
 
-func (x *CreateRoomReply) String() string {
+func (x *RefreshTokenReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoomReply) ProtoMessage() {}
+func (*RefreshTokenReply) ProtoMessage() {}
 
-func (x *CreateRoomReply) ProtoReflect() protoreflect.Message {
+func (x *RefreshTokenReply) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -655,35 +1073,42 @@ func (x *CreateRoomReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoomReply.ProtoReflect.Descriptor instead.
-func (*CreateRoomReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshTokenReply.ProtoReflect.Descriptor instead.
+func (*RefreshTokenReply) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateRoomReply) GetMessage() string {
+func (x *RefreshTokenReply) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *CreateRoomReply) GetRoom() *Room {
+func (x *RefreshTokenReply) GetToken() string {
 	if x != nil {
-		return x.Room
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-type JoinRoomRequest struct {
+func (x *RefreshTokenReply) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type LogoutReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomID string `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *JoinRoomRequest) Reset() {
-	*x = JoinRoomRequest{}
+func (x *LogoutReply) Reset() {
+	*x = LogoutReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -691,13 +1116,13 @@ func (x *JoinRoomRequest) Reset() {
 	}
 }
 
-func (x *JoinRoomRequest) String() string {
+func (x *LogoutReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinRoomRequest) ProtoMessage() {}
+func (*LogoutReply) ProtoMessage() {}
 
-func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
+func (x *LogoutReply) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -709,30 +1134,31 @@ func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinRoomRequest.ProtoReflect.Descriptor instead.
-func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogoutReply.ProtoReflect.Descriptor instead.
+func (*LogoutReply) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *JoinRoomRequest) GetRoomID() string {
+func (x *LogoutReply) GetMessage() string {
 	if x != nil {
-		return x.RoomID
+		return x.Message
 	}
 	return ""
 }
 
-type JoinRoomReply struct {
+type ReconnectInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Room    *Room  `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
-	Seat    int32  `protobuf:"varint,3,opt,name=seat,proto3" json:"seat,omitempty"`
+	RoomID      string        `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	GameInfo    *GameInfo     `protobuf:"bytes,2,opt,name=gameInfo,proto3" json:"gameInfo,omitempty"`
+	HandTiles   []int32       `protobuf:"varint,3,rep,packed,name=handTiles,proto3" json:"handTiles,omitempty"`
+	PlayerInfos []*PlayerInfo `protobuf:"bytes,4,rep,name=playerInfos,proto3" json:"playerInfos,omitempty"`
 }
 
-func (x *JoinRoomReply) Reset() {
-	*x = JoinRoomReply{}
+func (x *ReconnectInfo) Reset() {
+	*x = ReconnectInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -740,13 +1166,13 @@ func (x *JoinRoomReply) Reset() {
 	}
 }
 
-func (x *JoinRoomReply) String() string {
+func (x *ReconnectInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinRoomReply) ProtoMessage() {}
+func (*ReconnectInfo) ProtoMessage() {}
 
-func (x *JoinRoomReply) ProtoReflect() protoreflect.Message {
+func (x *ReconnectInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -758,42 +1184,54 @@ func (x *JoinRoomReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinRoomReply.ProtoReflect.Descriptor instead.
-func (*JoinRoomReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReconnectInfo.ProtoReflect.Descriptor instead.
+func (*ReconnectInfo) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *JoinRoomReply) GetMessage() string {
+func (x *ReconnectInfo) GetRoomID() string {
 	if x != nil {
-		return x.Message
+		return x.RoomID
 	}
 	return ""
 }
 
-func (x *JoinRoomReply) GetRoom() *Room {
+func (x *ReconnectInfo) GetGameInfo() *GameInfo {
 	if x != nil {
-		return x.Room
+		return x.GameInfo
 	}
 	return nil
 }
 
-func (x *JoinRoomReply) GetSeat() int32 {
+func (x *ReconnectInfo) GetHandTiles() []int32 {
 	if x != nil {
-		return x.Seat
+		return x.HandTiles
 	}
-	return 0
+	return nil
 }
 
-type RefreshRoomRequest struct {
+func (x *ReconnectInfo) GetPlayerInfos() []*PlayerInfo {
+	if x != nil {
+		return x.PlayerInfos
+	}
+	return nil
+}
+
+type PlayerInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomName *string `protobuf:"bytes,1,opt,name=roomName,proto3,oneof" json:"roomName,omitempty"`
+	PlayerName   string    `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	PlayerWind   Wind      `protobuf:"varint,2,opt,name=playerWind,proto3,enum=mahjong.Wind" json:"playerWind,omitempty"`
+	PlayerPoints int32     `protobuf:"varint,3,opt,name=playerPoints,proto3" json:"playerPoints,omitempty"`
+	BoardTiles   []int32   `protobuf:"varint,4,rep,packed,name=boardTiles,proto3" json:"boardTiles,omitempty"`
+	Actions      []*Action `protobuf:"bytes,5,rep,name=actions,proto3" json:"actions,omitempty"`
+	IsRiichi     bool      `protobuf:"varint,6,opt,name=isRiichi,proto3" json:"isRiichi,omitempty"`
 }
 
-func (x *RefreshRoomRequest) Reset() {
-	*x = RefreshRoomRequest{}
+func (x *PlayerInfo) Reset() {
+	*x = PlayerInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -801,13 +1239,13 @@ func (x *RefreshRoomRequest) Reset() {
 	}
 }
 
-func (x *RefreshRoomRequest) String() string {
+func (x *PlayerInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshRoomRequest) ProtoMessage() {}
+func (*PlayerInfo) ProtoMessage() {}
 
-func (x *RefreshRoomRequest) ProtoReflect() protoreflect.Message {
+func (x *PlayerInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -819,29 +1257,72 @@ func (x *RefreshRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshRoomRequest.ProtoReflect.Descriptor instead.
-func (*RefreshRoomRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerInfo.ProtoReflect.Descriptor instead.
+func (*PlayerInfo) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *RefreshRoomRequest) GetRoomName() string {
-	if x != nil && x.RoomName != nil {
-		return *x.RoomName
+func (x *PlayerInfo) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
 	}
 	return ""
 }
 
-type RefreshRoomReply struct {
+func (x *PlayerInfo) GetPlayerWind() Wind {
+	if x != nil {
+		return x.PlayerWind
+	}
+	return Wind_East
+}
+
+func (x *PlayerInfo) GetPlayerPoints() int32 {
+	if x != nil {
+		return x.PlayerPoints
+	}
+	return 0
+}
+
+func (x *PlayerInfo) GetBoardTiles() []int32 {
+	if x != nil {
+		return x.BoardTiles
+	}
+	return nil
+}
+
+func (x *PlayerInfo) GetActions() []*Action {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *PlayerInfo) GetIsRiichi() bool {
+	if x != nil {
+		return x.IsRiichi
+	}
+	return false
+}
+
+type Room struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Rooms   []*Room `protobuf:"bytes,2,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	RoomID      string       `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	RoomName    string       `protobuf:"bytes,2,opt,name=roomName,proto3" json:"roomName,omitempty"`
+	PlayerCount int32        `protobuf:"varint,3,opt,name=playerCount,proto3" json:"playerCount,omitempty"`
+	OwnerName   string       `protobuf:"bytes,4,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	RuleSet     string       `protobuf:"bytes,5,opt,name=ruleSet,proto3" json:"ruleSet,omitempty"`
+	OwnerRank   string       `protobuf:"bytes,6,opt,name=ownerRank,proto3" json:"ownerRank,omitempty"`
+	Private     bool         `protobuf:"varint,7,opt,name=private,proto3" json:"private,omitempty"`
+	HasPassword bool         `protobuf:"varint,8,opt,name=hasPassword,proto3" json:"hasPassword,omitempty"`
+	SeatDraw    SeatDrawMode `protobuf:"varint,9,opt,name=seatDraw,proto3,enum=mahjong.SeatDrawMode" json:"seatDraw,omitempty"`
+	Status      RoomStatus   `protobuf:"varint,10,opt,name=status,proto3,enum=mahjong.RoomStatus" json:"status,omitempty"`
 }
 
-func (x *RefreshRoomReply) Reset() {
-	*x = RefreshRoomReply{}
+func (x *Room) Reset() {
+	*x = Room{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -849,13 +1330,13 @@ func (x *RefreshRoomReply) Reset() {
 	}
 }
 
-func (x *RefreshRoomReply) String() string {
+func (x *Room) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshRoomReply) ProtoMessage() {}
+func (*Room) ProtoMessage() {}
 
-func (x *RefreshRoomReply) ProtoReflect() protoreflect.Message {
+func (x *Room) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -867,44 +1348,99 @@ func (x *RefreshRoomReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshRoomReply.ProtoReflect.Descriptor instead.
-func (*RefreshRoomReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use Room.ProtoReflect.Descriptor instead.
+func (*Room) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *RefreshRoomReply) GetMessage() string {
+func (x *Room) GetRoomID() string {
 	if x != nil {
-		return x.Message
+		return x.RoomID
 	}
 	return ""
 }
 
-func (x *RefreshRoomReply) GetRooms() []*Room {
+func (x *Room) GetRoomName() string {
 	if x != nil {
-		return x.Rooms
+		return x.RoomName
 	}
-	return nil
+	return ""
 }
 
-type ReadyRequest struct {
+func (x *Room) GetPlayerCount() int32 {
+	if x != nil {
+		return x.PlayerCount
+	}
+	return 0
+}
+
+func (x *Room) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *Room) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *Room) GetOwnerRank() string {
+	if x != nil {
+		return x.OwnerRank
+	}
+	return ""
+}
+
+func (x *Room) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *Room) GetHasPassword() bool {
+	if x != nil {
+		return x.HasPassword
+	}
+	return false
+}
+
+func (x *Room) GetSeatDraw() SeatDrawMode {
+	if x != nil {
+		return x.SeatDraw
+	}
+	return SeatDrawMode_NoDraw
+}
+
+func (x *Room) GetStatus() RoomStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RoomStatus_RoomWaiting
+}
+
+type CreateRoomRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Request:
-	//
-	//	*ReadyRequest_GetReady
-	//	*ReadyRequest_CancelReady
-	//	*ReadyRequest_AddRobot
-	//	*ReadyRequest_RemovePlayer
-	//	*ReadyRequest_LeaveRoom
-	//	*ReadyRequest_StartGame
-	//	*ReadyRequest_Chat
-	Request isReadyRequest_Request `protobuf_oneof:"request"`
+	RoomName       string          `protobuf:"bytes,1,opt,name=roomName,proto3" json:"roomName,omitempty"`
+	RuleSet        *string         `protobuf:"bytes,2,opt,name=ruleSet,proto3,oneof" json:"ruleSet,omitempty"`
+	Private        bool            `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"` // hidden from RefreshRoom, joined with the invite code or the password
+	Password       *string         `protobuf:"bytes,4,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	SeatDraw       SeatDrawMode    `protobuf:"varint,5,opt,name=seatDraw,proto3,enum=mahjong.SeatDrawMode" json:"seatDraw,omitempty"`
+	SeatDrawSeed   *int64          `protobuf:"varint,6,opt,name=seatDrawSeed,proto3,oneof" json:"seatDrawSeed,omitempty"`                               // a random seed is used if unset
+	RematchSeating *SeatDrawMode   `protobuf:"varint,7,opt,name=rematchSeating,proto3,enum=mahjong.SeatDrawMode,oneof" json:"rematchSeating,omitempty"` // seats of a rematch, RotateDraw if unset
+	ReplaceLeavers bool            `protobuf:"varint,8,opt,name=replaceLeavers,proto3" json:"replaceLeavers,omitempty"`                                 // robots take the seats of players leaving after a match
+	Scoring        *SessionScoring `protobuf:"bytes,9,opt,name=scoring,proto3,oneof" json:"scoring,omitempty"`                                          // 25000/30000 with 10-20 uma if unset
 }
 
-func (x *ReadyRequest) Reset() {
-	*x = ReadyRequest{}
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -912,13 +1448,13 @@ func (x *ReadyRequest) Reset() {
 	}
 }
 
-func (x *ReadyRequest) String() string {
+func (x *CreateRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadyRequest) ProtoMessage() {}
+func (*CreateRoomRequest) ProtoMessage() {}
 
-func (x *ReadyRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -930,148 +1466,164 @@ func (x *ReadyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadyRequest.ProtoReflect.Descriptor instead.
-func (*ReadyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{13}
 }
 
-func (m *ReadyRequest) GetRequest() isReadyRequest_Request {
-	if m != nil {
-		return m.Request
+func (x *CreateRoomRequest) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadyRequest) GetGetReady() *Empty {
-	if x, ok := x.GetRequest().(*ReadyRequest_GetReady); ok {
-		return x.GetReady
+func (x *CreateRoomRequest) GetRuleSet() string {
+	if x != nil && x.RuleSet != nil {
+		return *x.RuleSet
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadyRequest) GetCancelReady() *Empty {
-	if x, ok := x.GetRequest().(*ReadyRequest_CancelReady); ok {
-		return x.CancelReady
+func (x *CreateRoomRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
 	}
-	return nil
+	return false
 }
 
-func (x *ReadyRequest) GetAddRobot() *AddRobotRequest {
-	if x, ok := x.GetRequest().(*ReadyRequest_AddRobot); ok {
-		return x.AddRobot
+func (x *CreateRoomRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadyRequest) GetRemovePlayer() *RemovePlayerRequest {
-	if x, ok := x.GetRequest().(*ReadyRequest_RemovePlayer); ok {
-		return x.RemovePlayer
+func (x *CreateRoomRequest) GetSeatDraw() SeatDrawMode {
+	if x != nil {
+		return x.SeatDraw
 	}
-	return nil
+	return SeatDrawMode_NoDraw
 }
 
-func (x *ReadyRequest) GetLeaveRoom() *LeaveRoomRequest {
-	if x, ok := x.GetRequest().(*ReadyRequest_LeaveRoom); ok {
-		return x.LeaveRoom
+func (x *CreateRoomRequest) GetSeatDrawSeed() int64 {
+	if x != nil && x.SeatDrawSeed != nil {
+		return *x.SeatDrawSeed
 	}
-	return nil
+	return 0
 }
 
-func (x *ReadyRequest) GetStartGame() *Empty {
-	if x, ok := x.GetRequest().(*ReadyRequest_StartGame); ok {
-		return x.StartGame
+func (x *CreateRoomRequest) GetRematchSeating() SeatDrawMode {
+	if x != nil && x.RematchSeating != nil {
+		return *x.RematchSeating
 	}
-	return nil
+	return SeatDrawMode_NoDraw
 }
 
-func (x *ReadyRequest) GetChat() *ChatRequest {
-	if x, ok := x.GetRequest().(*ReadyRequest_Chat); ok {
-		return x.Chat
+func (x *CreateRoomRequest) GetReplaceLeavers() bool {
+	if x != nil {
+		return x.ReplaceLeavers
 	}
-	return nil
+	return false
 }
 
-type isReadyRequest_Request interface {
-	isReadyRequest_Request()
+func (x *CreateRoomRequest) GetScoring() *SessionScoring {
+	if x != nil {
+		return x.Scoring
+	}
+	return nil
 }
 
-type ReadyRequest_GetReady struct {
-	GetReady *Empty `protobuf:"bytes,2,opt,name=getReady,proto3,oneof"`
-}
+type CreateRoomReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type ReadyRequest_CancelReady struct {
-	CancelReady *Empty `protobuf:"bytes,3,opt,name=cancelReady,proto3,oneof"`
+	Message    string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Room       *Room  `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	InviteCode string `protobuf:"bytes,3,opt,name=inviteCode,proto3" json:"inviteCode,omitempty"`
 }
 
-type ReadyRequest_AddRobot struct {
-	AddRobot *AddRobotRequest `protobuf:"bytes,4,opt,name=addRobot,proto3,oneof"`
+func (x *CreateRoomReply) Reset() {
+	*x = CreateRoomReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type ReadyRequest_RemovePlayer struct {
-	RemovePlayer *RemovePlayerRequest `protobuf:"bytes,5,opt,name=removePlayer,proto3,oneof"`
+func (x *CreateRoomReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ReadyRequest_LeaveRoom struct {
-	LeaveRoom *LeaveRoomRequest `protobuf:"bytes,6,opt,name=leaveRoom,proto3,oneof"`
-}
+func (*CreateRoomReply) ProtoMessage() {}
 
-type ReadyRequest_StartGame struct {
-	StartGame *Empty `protobuf:"bytes,7,opt,name=startGame,proto3,oneof"`
+func (x *CreateRoomReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type ReadyRequest_Chat struct {
-	Chat *ChatRequest `protobuf:"bytes,8,opt,name=chat,proto3,oneof"`
+// Deprecated: Use CreateRoomReply.ProtoReflect.Descriptor instead.
+func (*CreateRoomReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{14}
 }
 
-func (*ReadyRequest_GetReady) isReadyRequest_Request() {}
-
-func (*ReadyRequest_CancelReady) isReadyRequest_Request() {}
-
-func (*ReadyRequest_AddRobot) isReadyRequest_Request() {}
-
-func (*ReadyRequest_RemovePlayer) isReadyRequest_Request() {}
-
-func (*ReadyRequest_LeaveRoom) isReadyRequest_Request() {}
+func (x *CreateRoomReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
 
-func (*ReadyRequest_StartGame) isReadyRequest_Request() {}
+func (x *CreateRoomReply) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
 
-func (*ReadyRequest_Chat) isReadyRequest_Request() {}
+func (x *CreateRoomReply) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
 
-type ReadyReply struct {
+type JoinRoomRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	// Types that are assignable to Reply:
-	//
-	//	*ReadyReply_PlayerJoin
-	//	*ReadyReply_GetReady
-	//	*ReadyReply_CancelReady
-	//	*ReadyReply_AddRobot
-	//	*ReadyReply_PlayerLeave
-	//	*ReadyReply_StartGame
-	//	*ReadyReply_Chat
-	Reply isReadyReply_Reply `protobuf_oneof:"reply"`
+	RoomID     string  `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"` // may be empty when joining with an invite code
+	Password   *string `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	InviteCode *string `protobuf:"bytes,3,opt,name=inviteCode,proto3,oneof" json:"inviteCode,omitempty"`
 }
 
-func (x *ReadyReply) Reset() {
-	*x = ReadyReply{}
+func (x *JoinRoomRequest) Reset() {
+	*x = JoinRoomRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[14]
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadyReply) String() string {
+func (x *JoinRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadyReply) ProtoMessage() {}
+func (*JoinRoomRequest) ProtoMessage() {}
 
-func (x *ReadyReply) ProtoReflect() protoreflect.Message {
-	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[14]
+func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1082,151 +1634,128 @@ func (x *ReadyReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadyReply.ProtoReflect.Descriptor instead.
-func (*ReadyReply) Descriptor() ([]byte, []int) {
-	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use JoinRoomRequest.ProtoReflect.Descriptor instead.
+func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ReadyReply) GetMessage() string {
+func (x *JoinRoomRequest) GetRoomID() string {
 	if x != nil {
-		return x.Message
+		return x.RoomID
 	}
 	return ""
 }
 
-func (m *ReadyReply) GetReply() isReadyReply_Reply {
-	if m != nil {
-		return m.Reply
+func (x *JoinRoomRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadyReply) GetPlayerJoin() *PlayerJoinReply {
-	if x, ok := x.GetReply().(*ReadyReply_PlayerJoin); ok {
-		return x.PlayerJoin
+func (x *JoinRoomRequest) GetInviteCode() string {
+	if x != nil && x.InviteCode != nil {
+		return *x.InviteCode
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadyReply) GetGetReady() *GetReadyReply {
-	if x, ok := x.GetReply().(*ReadyReply_GetReady); ok {
-		return x.GetReady
-	}
-	return nil
-}
+type JoinRoomReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ReadyReply) GetCancelReady() *CancelReadyReply {
-	if x, ok := x.GetReply().(*ReadyReply_CancelReady); ok {
-		return x.CancelReady
-	}
-	return nil
+	Message    string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Room       *Room  `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	Seat       int32  `protobuf:"varint,3,opt,name=seat,proto3" json:"seat,omitempty"`
+	InviteCode string `protobuf:"bytes,4,opt,name=inviteCode,proto3" json:"inviteCode,omitempty"`
 }
 
-func (x *ReadyReply) GetAddRobot() *AddRobotReply {
-	if x, ok := x.GetReply().(*ReadyReply_AddRobot); ok {
-		return x.AddRobot
+func (x *JoinRoomReply) Reset() {
+	*x = JoinRoomReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ReadyReply) GetPlayerLeave() *PlayerLeaveReply {
-	if x, ok := x.GetReply().(*ReadyReply_PlayerLeave); ok {
-		return x.PlayerLeave
-	}
-	return nil
+func (x *JoinRoomReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ReadyReply) GetStartGame() *Empty {
-	if x, ok := x.GetReply().(*ReadyReply_StartGame); ok {
-		return x.StartGame
-	}
-	return nil
-}
+func (*JoinRoomReply) ProtoMessage() {}
 
-func (x *ReadyReply) GetChat() *ChatReply {
-	if x, ok := x.GetReply().(*ReadyReply_Chat); ok {
-		return x.Chat
+func (x *JoinRoomReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
-}
-
-type isReadyReply_Reply interface {
-	isReadyReply_Reply()
-}
-
-type ReadyReply_PlayerJoin struct {
-	PlayerJoin *PlayerJoinReply `protobuf:"bytes,2,opt,name=playerJoin,proto3,oneof"`
-}
-
-type ReadyReply_GetReady struct {
-	GetReady *GetReadyReply `protobuf:"bytes,3,opt,name=getReady,proto3,oneof"`
+	return mi.MessageOf(x)
 }
 
-type ReadyReply_CancelReady struct {
-	CancelReady *CancelReadyReply `protobuf:"bytes,4,opt,name=cancelReady,proto3,oneof"`
+// Deprecated: Use JoinRoomReply.ProtoReflect.Descriptor instead.
+func (*JoinRoomReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{16}
 }
 
-type ReadyReply_AddRobot struct {
-	AddRobot *AddRobotReply `protobuf:"bytes,5,opt,name=addRobot,proto3,oneof"`
+func (x *JoinRoomReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-type ReadyReply_PlayerLeave struct {
-	PlayerLeave *PlayerLeaveReply `protobuf:"bytes,6,opt,name=playerLeave,proto3,oneof"`
+func (x *JoinRoomReply) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
 }
 
-type ReadyReply_StartGame struct {
-	StartGame *Empty `protobuf:"bytes,7,opt,name=startGame,proto3,oneof"`
+func (x *JoinRoomReply) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
 }
 
-type ReadyReply_Chat struct {
-	Chat *ChatReply `protobuf:"bytes,8,opt,name=chat,proto3,oneof"`
+func (x *JoinRoomReply) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
 }
 
-func (*ReadyReply_PlayerJoin) isReadyReply_Reply() {}
-
-func (*ReadyReply_GetReady) isReadyReply_Reply() {}
-
-func (*ReadyReply_CancelReady) isReadyReply_Reply() {}
-
-func (*ReadyReply_AddRobot) isReadyReply_Reply() {}
-
-func (*ReadyReply_PlayerLeave) isReadyReply_Reply() {}
-
-func (*ReadyReply_StartGame) isReadyReply_Reply() {}
-
-func (*ReadyReply_Chat) isReadyReply_Reply() {}
-
-type StartRequest struct {
+type RefreshRoomRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Request:
-	//
-	//	*StartRequest_Ping
-	//	*StartRequest_Action
-	//	*StartRequest_Next
-	//	*StartRequest_Chat
-	Request isStartRequest_Request `protobuf_oneof:"request"`
+	RoomName *string `protobuf:"bytes,1,opt,name=roomName,proto3,oneof" json:"roomName,omitempty"`
 }
 
-func (x *StartRequest) Reset() {
-	*x = StartRequest{}
+func (x *RefreshRoomRequest) Reset() {
+	*x = RefreshRoomRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[15]
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartRequest) String() string {
+func (x *RefreshRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartRequest) ProtoMessage() {}
+func (*RefreshRoomRequest) ProtoMessage() {}
 
-func (x *StartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[15]
+func (x *RefreshRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1237,75 +1766,121 @@ func (x *StartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartRequest.ProtoReflect.Descriptor instead.
-func (*StartRequest) Descriptor() ([]byte, []int) {
-	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RefreshRoomRequest.ProtoReflect.Descriptor instead.
+func (*RefreshRoomRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{17}
 }
 
-func (m *StartRequest) GetRequest() isStartRequest_Request {
-	if m != nil {
-		return m.Request
+func (x *RefreshRoomRequest) GetRoomName() string {
+	if x != nil && x.RoomName != nil {
+		return *x.RoomName
 	}
-	return nil
+	return ""
 }
 
-func (x *StartRequest) GetPing() string {
-	if x, ok := x.GetRequest().(*StartRequest_Ping); ok {
-		return x.Ping
+type RefreshRoomReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Rooms   []*Room `protobuf:"bytes,2,rep,name=rooms,proto3" json:"rooms,omitempty"`
+}
+
+func (x *RefreshRoomReply) Reset() {
+	*x = RefreshRoomReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *StartRequest) GetAction() *Action {
-	if x, ok := x.GetRequest().(*StartRequest_Action); ok {
-		return x.Action
+func (x *RefreshRoomReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshRoomReply) ProtoMessage() {}
+
+func (x *RefreshRoomReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *StartRequest) GetNext() string {
-	if x, ok := x.GetRequest().(*StartRequest_Next); ok {
-		return x.Next
+// Deprecated: Use RefreshRoomReply.ProtoReflect.Descriptor instead.
+func (*RefreshRoomReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RefreshRoomReply) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *StartRequest) GetChat() *ChatRequest {
-	if x, ok := x.GetRequest().(*StartRequest_Chat); ok {
-		return x.Chat
+func (x *RefreshRoomReply) GetRooms() []*Room {
+	if x != nil {
+		return x.Rooms
 	}
 	return nil
 }
 
-type isStartRequest_Request interface {
-	isStartRequest_Request()
-}
-
-type StartRequest_Ping struct {
-	Ping string `protobuf:"bytes,1,opt,name=ping,proto3,oneof"`
-}
+type WatchLobbyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type StartRequest_Action struct {
-	Action *Action `protobuf:"bytes,2,opt,name=action,proto3,oneof"`
+	RoomName *string `protobuf:"bytes,1,opt,name=roomName,proto3,oneof" json:"roomName,omitempty"` // only rooms whose name contains it, like RefreshRoomRequest
 }
 
-type StartRequest_Next struct {
-	Next string `protobuf:"bytes,3,opt,name=next,proto3,oneof"`
+func (x *WatchLobbyRequest) Reset() {
+	*x = WatchLobbyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type StartRequest_Chat struct {
-	Chat *ChatRequest `protobuf:"bytes,4,opt,name=chat,proto3,oneof"`
+func (x *WatchLobbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartRequest_Ping) isStartRequest_Request() {}
+func (*WatchLobbyRequest) ProtoMessage() {}
 
-func (*StartRequest_Action) isStartRequest_Request() {}
+func (x *WatchLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*StartRequest_Next) isStartRequest_Request() {}
+// Deprecated: Use WatchLobbyRequest.ProtoReflect.Descriptor instead.
+func (*WatchLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{19}
+}
 
-func (*StartRequest_Chat) isStartRequest_Request() {}
+func (x *WatchLobbyRequest) GetRoomName() string {
+	if x != nil && x.RoomName != nil {
+		return *x.RoomName
+	}
+	return ""
+}
 
-type StartReply struct {
+type LobbyReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1313,34 +1888,31 @@ type StartReply struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	// Types that are assignable to Reply:
 	//
-	//	*StartReply_Pong
-	//	*StartReply_Draw
-	//	*StartReply_Discard
-	//	*StartReply_Call
-	//	*StartReply_GameStart
-	//	*StartReply_GameInitInfo
-	//	*StartReply_Chat
-	Reply        isStartReply_Reply `protobuf_oneof:"reply"`
-	ValidActions []*Action          `protobuf:"bytes,4,rep,name=validActions,proto3" json:"validActions,omitempty"`
+	//	*LobbyReply_Snapshot
+	//	*LobbyReply_RoomCreated
+	//	*LobbyReply_PlayerCountChanged
+	//	*LobbyReply_StatusChanged
+	//	*LobbyReply_RoomClosed
+	Reply isLobbyReply_Reply `protobuf_oneof:"reply"`
 }
 
-func (x *StartReply) Reset() {
-	*x = StartReply{}
+func (x *LobbyReply) Reset() {
+	*x = LobbyReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[16]
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartReply) String() string {
+func (x *LobbyReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartReply) ProtoMessage() {}
+func (*LobbyReply) ProtoMessage() {}
 
-func (x *StartReply) ProtoReflect() protoreflect.Message {
-	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[16]
+func (x *LobbyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1351,152 +1923,119 @@ func (x *StartReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartReply.ProtoReflect.Descriptor instead.
-func (*StartReply) Descriptor() ([]byte, []int) {
-	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use LobbyReply.ProtoReflect.Descriptor instead.
+func (*LobbyReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *StartReply) GetMessage() string {
+func (x *LobbyReply) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (m *StartReply) GetReply() isStartReply_Reply {
+func (m *LobbyReply) GetReply() isLobbyReply_Reply {
 	if m != nil {
 		return m.Reply
 	}
 	return nil
 }
 
-func (x *StartReply) GetPong() string {
-	if x, ok := x.GetReply().(*StartReply_Pong); ok {
-		return x.Pong
-	}
-	return ""
-}
-
-func (x *StartReply) GetDraw() *DrawMsg {
-	if x, ok := x.GetReply().(*StartReply_Draw); ok {
-		return x.Draw
-	}
-	return nil
-}
-
-func (x *StartReply) GetDiscard() *DiscardMsg {
-	if x, ok := x.GetReply().(*StartReply_Discard); ok {
-		return x.Discard
+func (x *LobbyReply) GetSnapshot() *LobbySnapshotReply {
+	if x, ok := x.GetReply().(*LobbyReply_Snapshot); ok {
+		return x.Snapshot
 	}
 	return nil
 }
 
-func (x *StartReply) GetCall() *CallMsg {
-	if x, ok := x.GetReply().(*StartReply_Call); ok {
-		return x.Call
+func (x *LobbyReply) GetRoomCreated() *Room {
+	if x, ok := x.GetReply().(*LobbyReply_RoomCreated); ok {
+		return x.RoomCreated
 	}
 	return nil
 }
 
-func (x *StartReply) GetGameStart() string {
-	if x, ok := x.GetReply().(*StartReply_GameStart); ok {
-		return x.GameStart
-	}
-	return ""
-}
-
-func (x *StartReply) GetGameInitInfo() *GameInfo {
-	if x, ok := x.GetReply().(*StartReply_GameInitInfo); ok {
-		return x.GameInitInfo
+func (x *LobbyReply) GetPlayerCountChanged() *Room {
+	if x, ok := x.GetReply().(*LobbyReply_PlayerCountChanged); ok {
+		return x.PlayerCountChanged
 	}
 	return nil
 }
 
-func (x *StartReply) GetChat() *ChatReply {
-	if x, ok := x.GetReply().(*StartReply_Chat); ok {
-		return x.Chat
+func (x *LobbyReply) GetStatusChanged() *Room {
+	if x, ok := x.GetReply().(*LobbyReply_StatusChanged); ok {
+		return x.StatusChanged
 	}
 	return nil
 }
 
-func (x *StartReply) GetValidActions() []*Action {
-	if x != nil {
-		return x.ValidActions
+func (x *LobbyReply) GetRoomClosed() *LobbyRoomClosedReply {
+	if x, ok := x.GetReply().(*LobbyReply_RoomClosed); ok {
+		return x.RoomClosed
 	}
 	return nil
 }
 
-type isStartReply_Reply interface {
-	isStartReply_Reply()
-}
-
-type StartReply_Pong struct {
-	Pong string `protobuf:"bytes,2,opt,name=pong,proto3,oneof"`
-}
-
-type StartReply_Draw struct {
-	Draw *DrawMsg `protobuf:"bytes,3,opt,name=draw,proto3,oneof"`
+type isLobbyReply_Reply interface {
+	isLobbyReply_Reply()
 }
 
-type StartReply_Discard struct {
-	Discard *DiscardMsg `protobuf:"bytes,5,opt,name=discard,proto3,oneof"`
+type LobbyReply_Snapshot struct {
+	Snapshot *LobbySnapshotReply `protobuf:"bytes,2,opt,name=snapshot,proto3,oneof"` // always the first reply
 }
 
-type StartReply_Call struct {
-	Call *CallMsg `protobuf:"bytes,6,opt,name=call,proto3,oneof"`
+type LobbyReply_RoomCreated struct {
+	RoomCreated *Room `protobuf:"bytes,3,opt,name=roomCreated,proto3,oneof"`
 }
 
-type StartReply_GameStart struct {
-	GameStart string `protobuf:"bytes,7,opt,name=gameStart,proto3,oneof"`
+type LobbyReply_PlayerCountChanged struct {
+	PlayerCountChanged *Room `protobuf:"bytes,4,opt,name=playerCountChanged,proto3,oneof"`
 }
 
-type StartReply_GameInitInfo struct {
-	GameInitInfo *GameInfo `protobuf:"bytes,8,opt,name=gameInitInfo,proto3,oneof"`
+type LobbyReply_StatusChanged struct {
+	StatusChanged *Room `protobuf:"bytes,5,opt,name=statusChanged,proto3,oneof"`
 }
 
-type StartReply_Chat struct {
-	Chat *ChatReply `protobuf:"bytes,9,opt,name=chat,proto3,oneof"`
+type LobbyReply_RoomClosed struct {
+	RoomClosed *LobbyRoomClosedReply `protobuf:"bytes,6,opt,name=roomClosed,proto3,oneof"`
 }
 
-func (*StartReply_Pong) isStartReply_Reply() {}
-
-func (*StartReply_Draw) isStartReply_Reply() {}
-
-func (*StartReply_Discard) isStartReply_Reply() {}
+func (*LobbyReply_Snapshot) isLobbyReply_Reply() {}
 
-func (*StartReply_Call) isStartReply_Reply() {}
+func (*LobbyReply_RoomCreated) isLobbyReply_Reply() {}
 
-func (*StartReply_GameStart) isStartReply_Reply() {}
+func (*LobbyReply_PlayerCountChanged) isLobbyReply_Reply() {}
 
-func (*StartReply_GameInitInfo) isStartReply_Reply() {}
+func (*LobbyReply_StatusChanged) isLobbyReply_Reply() {}
 
-func (*StartReply_Chat) isStartReply_Reply() {}
+func (*LobbyReply_RoomClosed) isLobbyReply_Reply() {}
 
-type LeaveRoomRequest struct {
+type LobbySnapshotReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomID string `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	Rooms []*Room `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
 }
 
-func (x *LeaveRoomRequest) Reset() {
-	*x = LeaveRoomRequest{}
+func (x *LobbySnapshotReply) Reset() {
+	*x = LobbySnapshotReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[17]
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LeaveRoomRequest) String() string {
+func (x *LobbySnapshotReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveRoomRequest) ProtoMessage() {}
+func (*LobbySnapshotReply) ProtoMessage() {}
 
-func (x *LeaveRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[17]
+func (x *LobbySnapshotReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1507,44 +2046,44 @@ func (x *LeaveRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveRoomRequest.ProtoReflect.Descriptor instead.
-func (*LeaveRoomRequest) Descriptor() ([]byte, []int) {
-	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use LobbySnapshotReply.ProtoReflect.Descriptor instead.
+func (*LobbySnapshotReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *LeaveRoomRequest) GetRoomID() string {
+func (x *LobbySnapshotReply) GetRooms() []*Room {
 	if x != nil {
-		return x.RoomID
+		return x.Rooms
 	}
-	return ""
+	return nil
 }
 
-type AddRobotRequest struct {
+type LobbyRoomClosedReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RobotSeat  int32  `protobuf:"varint,1,opt,name=robotSeat,proto3" json:"robotSeat,omitempty"`
-	RobotLevel string `protobuf:"bytes,2,opt,name=robotLevel,proto3" json:"robotLevel,omitempty"`
+	RoomID   string `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	RoomName string `protobuf:"bytes,2,opt,name=roomName,proto3" json:"roomName,omitempty"`
 }
 
-func (x *AddRobotRequest) Reset() {
-	*x = AddRobotRequest{}
+func (x *LobbyRoomClosedReply) Reset() {
+	*x = LobbyRoomClosedReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[18]
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddRobotRequest) String() string {
+func (x *LobbyRoomClosedReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddRobotRequest) ProtoMessage() {}
+func (*LobbyRoomClosedReply) ProtoMessage() {}
 
-func (x *AddRobotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[18]
+func (x *LobbyRoomClosedReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1555,51 +2094,65 @@ func (x *AddRobotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddRobotRequest.ProtoReflect.Descriptor instead.
-func (*AddRobotRequest) Descriptor() ([]byte, []int) {
-	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use LobbyRoomClosedReply.ProtoReflect.Descriptor instead.
+func (*LobbyRoomClosedReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AddRobotRequest) GetRobotSeat() int32 {
+func (x *LobbyRoomClosedReply) GetRoomID() string {
 	if x != nil {
-		return x.RobotSeat
+		return x.RoomID
 	}
-	return 0
+	return ""
 }
 
-func (x *AddRobotRequest) GetRobotLevel() string {
+func (x *LobbyRoomClosedReply) GetRoomName() string {
 	if x != nil {
-		return x.RobotLevel
+		return x.RoomName
 	}
 	return ""
 }
 
-type RemovePlayerRequest struct {
+type ReadyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerSeat int32 `protobuf:"varint,1,opt,name=playerSeat,proto3" json:"playerSeat,omitempty"`
+	// Types that are assignable to Request:
+	//
+	//	*ReadyRequest_GetReady
+	//	*ReadyRequest_CancelReady
+	//	*ReadyRequest_AddRobot
+	//	*ReadyRequest_RemovePlayer
+	//	*ReadyRequest_LeaveRoom
+	//	*ReadyRequest_StartGame
+	//	*ReadyRequest_Chat
+	//	*ReadyRequest_SwapSeats
+	//	*ReadyRequest_MoveSeat
+	//	*ReadyRequest_KickPlayer
+	//	*ReadyRequest_TransferOwner
+	//	*ReadyRequest_Rematch
+	Request isReadyRequest_Request `protobuf_oneof:"request"`
 }
 
-func (x *RemovePlayerRequest) Reset() {
-	*x = RemovePlayerRequest{}
+func (x *ReadyRequest) Reset() {
+	*x = ReadyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[19]
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemovePlayerRequest) String() string {
+func (x *ReadyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemovePlayerRequest) ProtoMessage() {}
+func (*ReadyRequest) ProtoMessage() {}
 
-func (x *RemovePlayerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *ReadyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1609,202 +2162,219 @@ func (x *RemovePlayerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemovePlayerRequest.ProtoReflect.Descriptor instead.
-func (*RemovePlayerRequest) Descriptor() ([]byte, []int) {
-	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ReadyRequest.ProtoReflect.Descriptor instead.
+func (*ReadyRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *RemovePlayerRequest) GetPlayerSeat() int32 {
-	if x != nil {
-		return x.PlayerSeat
+func (m *ReadyRequest) GetRequest() isReadyRequest_Request {
+	if m != nil {
+		return m.Request
 	}
-	return 0
+	return nil
 }
 
-type Action struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Type    ActionType `protobuf:"varint,1,opt,name=type,proto3,enum=mahjong.ActionType" json:"type,omitempty"`
-	Tiles   []int32    `protobuf:"varint,2,rep,packed,name=tiles,proto3" json:"tiles,omitempty"`
-	FromWho []Wind     `protobuf:"varint,3,rep,packed,name=fromWho,proto3,enum=mahjong.Wind" json:"fromWho,omitempty"`
+func (x *ReadyRequest) GetGetReady() *Empty {
+	if x, ok := x.GetRequest().(*ReadyRequest_GetReady); ok {
+		return x.GetReady
+	}
+	return nil
 }
 
-func (x *Action) Reset() {
-	*x = Action{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReadyRequest) GetCancelReady() *Empty {
+	if x, ok := x.GetRequest().(*ReadyRequest_CancelReady); ok {
+		return x.CancelReady
 	}
+	return nil
 }
 
-func (x *Action) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ReadyRequest) GetAddRobot() *AddRobotRequest {
+	if x, ok := x.GetRequest().(*ReadyRequest_AddRobot); ok {
+		return x.AddRobot
+	}
+	return nil
 }
 
-func (*Action) ProtoMessage() {}
+func (x *ReadyRequest) GetRemovePlayer() *RemovePlayerRequest {
+	if x, ok := x.GetRequest().(*ReadyRequest_RemovePlayer); ok {
+		return x.RemovePlayer
+	}
+	return nil
+}
 
-func (x *Action) ProtoReflect() protoreflect.Message {
-	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ReadyRequest) GetLeaveRoom() *LeaveRoomRequest {
+	if x, ok := x.GetRequest().(*ReadyRequest_LeaveRoom); ok {
+		return x.LeaveRoom
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Action.ProtoReflect.Descriptor instead.
-func (*Action) Descriptor() ([]byte, []int) {
-	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{20}
+func (x *ReadyRequest) GetStartGame() *Empty {
+	if x, ok := x.GetRequest().(*ReadyRequest_StartGame); ok {
+		return x.StartGame
+	}
+	return nil
 }
 
-func (x *Action) GetType() ActionType {
-	if x != nil {
-		return x.Type
+func (x *ReadyRequest) GetChat() *ChatRequest {
+	if x, ok := x.GetRequest().(*ReadyRequest_Chat); ok {
+		return x.Chat
 	}
-	return ActionType_Skip
+	return nil
 }
 
-func (x *Action) GetTiles() []int32 {
-	if x != nil {
-		return x.Tiles
+func (x *ReadyRequest) GetSwapSeats() *SwapSeatsRequest {
+	if x, ok := x.GetRequest().(*ReadyRequest_SwapSeats); ok {
+		return x.SwapSeats
 	}
 	return nil
 }
 
-func (x *Action) GetFromWho() []Wind {
-	if x != nil {
-		return x.FromWho
+func (x *ReadyRequest) GetMoveSeat() *MoveSeatRequest {
+	if x, ok := x.GetRequest().(*ReadyRequest_MoveSeat); ok {
+		return x.MoveSeat
 	}
 	return nil
 }
 
-type GameInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ReadyRequest) GetKickPlayer() *KickPlayerRequest {
+	if x, ok := x.GetRequest().(*ReadyRequest_KickPlayer); ok {
+		return x.KickPlayer
+	}
+	return nil
+}
 
-	Wind        Wind    `protobuf:"varint,1,opt,name=wind,proto3,enum=mahjong.Wind" json:"wind,omitempty"`
-	Dora        []int32 `protobuf:"varint,2,rep,packed,name=dora,proto3" json:"dora,omitempty"`
-	Tiles       []int32 `protobuf:"varint,3,rep,packed,name=tiles,proto3" json:"tiles,omitempty"`
-	WindRound   int32   `protobuf:"varint,4,opt,name=windRound,proto3" json:"windRound,omitempty"` // 东一、二、三、四；南一、二、三、四...
-	RoundNumber int32   `protobuf:"varint,5,opt,name=roundNumber,proto3" json:"roundNumber,omitempty"`
-	RiichiNum   int32   `protobuf:"varint,6,opt,name=riichiNum,proto3" json:"riichiNum,omitempty"` // 立直棒个数
-	HonbaNum    int32   `protobuf:"varint,7,opt,name=honbaNum,proto3" json:"honbaNum,omitempty"`   // 本场数
+func (x *ReadyRequest) GetTransferOwner() *TransferOwnerRequest {
+	if x, ok := x.GetRequest().(*ReadyRequest_TransferOwner); ok {
+		return x.TransferOwner
+	}
+	return nil
 }
 
-func (x *GameInfo) Reset() {
-	*x = GameInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReadyRequest) GetRematch() *RematchRequest {
+	if x, ok := x.GetRequest().(*ReadyRequest_Rematch); ok {
+		return x.Rematch
 	}
+	return nil
 }
 
-func (x *GameInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type isReadyRequest_Request interface {
+	isReadyRequest_Request()
 }
 
-func (*GameInfo) ProtoMessage() {}
+type ReadyRequest_GetReady struct {
+	GetReady *Empty `protobuf:"bytes,2,opt,name=getReady,proto3,oneof"`
+}
 
-func (x *GameInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type ReadyRequest_CancelReady struct {
+	CancelReady *Empty `protobuf:"bytes,3,opt,name=cancelReady,proto3,oneof"`
 }
 
-// Deprecated: Use GameInfo.ProtoReflect.Descriptor instead.
-func (*GameInfo) Descriptor() ([]byte, []int) {
-	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{21}
+type ReadyRequest_AddRobot struct {
+	AddRobot *AddRobotRequest `protobuf:"bytes,4,opt,name=addRobot,proto3,oneof"`
 }
 
-func (x *GameInfo) GetWind() Wind {
-	if x != nil {
-		return x.Wind
-	}
-	return Wind_East
+type ReadyRequest_RemovePlayer struct {
+	RemovePlayer *RemovePlayerRequest `protobuf:"bytes,5,opt,name=removePlayer,proto3,oneof"`
 }
 
-func (x *GameInfo) GetDora() []int32 {
-	if x != nil {
-		return x.Dora
-	}
-	return nil
+type ReadyRequest_LeaveRoom struct {
+	LeaveRoom *LeaveRoomRequest `protobuf:"bytes,6,opt,name=leaveRoom,proto3,oneof"`
 }
 
-func (x *GameInfo) GetTiles() []int32 {
-	if x != nil {
-		return x.Tiles
-	}
-	return nil
+type ReadyRequest_StartGame struct {
+	StartGame *Empty `protobuf:"bytes,7,opt,name=startGame,proto3,oneof"`
 }
 
-func (x *GameInfo) GetWindRound() int32 {
-	if x != nil {
-		return x.WindRound
-	}
-	return 0
+type ReadyRequest_Chat struct {
+	Chat *ChatRequest `protobuf:"bytes,8,opt,name=chat,proto3,oneof"`
 }
 
-func (x *GameInfo) GetRoundNumber() int32 {
-	if x != nil {
-		return x.RoundNumber
-	}
-	return 0
+type ReadyRequest_SwapSeats struct {
+	SwapSeats *SwapSeatsRequest `protobuf:"bytes,9,opt,name=swapSeats,proto3,oneof"`
 }
 
-func (x *GameInfo) GetRiichiNum() int32 {
-	if x != nil {
-		return x.RiichiNum
-	}
-	return 0
+type ReadyRequest_MoveSeat struct {
+	MoveSeat *MoveSeatRequest `protobuf:"bytes,10,opt,name=moveSeat,proto3,oneof"`
 }
 
-func (x *GameInfo) GetHonbaNum() int32 {
-	if x != nil {
-		return x.HonbaNum
-	}
-	return 0
+type ReadyRequest_KickPlayer struct {
+	KickPlayer *KickPlayerRequest `protobuf:"bytes,11,opt,name=kickPlayer,proto3,oneof"`
 }
 
-type DrawMsg struct {
+type ReadyRequest_TransferOwner struct {
+	TransferOwner *TransferOwnerRequest `protobuf:"bytes,12,opt,name=transferOwner,proto3,oneof"`
+}
+
+type ReadyRequest_Rematch struct {
+	Rematch *RematchRequest `protobuf:"bytes,13,opt,name=rematch,proto3,oneof"`
+}
+
+func (*ReadyRequest_GetReady) isReadyRequest_Request() {}
+
+func (*ReadyRequest_CancelReady) isReadyRequest_Request() {}
+
+func (*ReadyRequest_AddRobot) isReadyRequest_Request() {}
+
+func (*ReadyRequest_RemovePlayer) isReadyRequest_Request() {}
+
+func (*ReadyRequest_LeaveRoom) isReadyRequest_Request() {}
+
+func (*ReadyRequest_StartGame) isReadyRequest_Request() {}
+
+func (*ReadyRequest_Chat) isReadyRequest_Request() {}
+
+func (*ReadyRequest_SwapSeats) isReadyRequest_Request() {}
+
+func (*ReadyRequest_MoveSeat) isReadyRequest_Request() {}
+
+func (*ReadyRequest_KickPlayer) isReadyRequest_Request() {}
+
+func (*ReadyRequest_TransferOwner) isReadyRequest_Request() {}
+
+func (*ReadyRequest_Rematch) isReadyRequest_Request() {}
+
+type ReadyReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Who  Wind   `protobuf:"varint,1,opt,name=who,proto3,enum=mahjong.Wind" json:"who,omitempty"`
-	Tile *int32 `protobuf:"varint,2,opt,name=tile,proto3,oneof" json:"tile,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Types that are assignable to Reply:
+	//
+	//	*ReadyReply_PlayerJoin
+	//	*ReadyReply_GetReady
+	//	*ReadyReply_CancelReady
+	//	*ReadyReply_AddRobot
+	//	*ReadyReply_PlayerLeave
+	//	*ReadyReply_StartGame
+	//	*ReadyReply_Chat
+	//	*ReadyReply_SwapSeats
+	//	*ReadyReply_MoveSeat
+	//	*ReadyReply_KickPlayer
+	//	*ReadyReply_TransferOwner
+	//	*ReadyReply_Rematch
+	//	*ReadyReply_Announcement
+	Reply isReadyReply_Reply `protobuf_oneof:"reply"`
 }
 
-func (x *DrawMsg) Reset() {
-	*x = DrawMsg{}
+func (x *ReadyReply) Reset() {
+	*x = ReadyReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[22]
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DrawMsg) String() string {
+func (x *ReadyReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DrawMsg) ProtoMessage() {}
+func (*ReadyReply) ProtoMessage() {}
 
-func (x *DrawMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[22]
+func (x *ReadyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1815,178 +2385,214 @@ func (x *DrawMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DrawMsg.ProtoReflect.Descriptor instead.
-func (*DrawMsg) Descriptor() ([]byte, []int) {
-	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ReadyReply.ProtoReflect.Descriptor instead.
+func (*ReadyReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *DrawMsg) GetWho() Wind {
+func (x *ReadyReply) GetMessage() string {
 	if x != nil {
-		return x.Who
+		return x.Message
 	}
-	return Wind_East
+	return ""
 }
 
-func (x *DrawMsg) GetTile() int32 {
-	if x != nil && x.Tile != nil {
-		return *x.Tile
+func (m *ReadyReply) GetReply() isReadyReply_Reply {
+	if m != nil {
+		return m.Reply
 	}
-	return 0
+	return nil
 }
 
-type DiscardMsg struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ReadyReply) GetPlayerJoin() *PlayerJoinReply {
+	if x, ok := x.GetReply().(*ReadyReply_PlayerJoin); ok {
+		return x.PlayerJoin
+	}
+	return nil
+}
 
-	Who       Wind  `protobuf:"varint,1,opt,name=who,proto3,enum=mahjong.Wind" json:"who,omitempty"`
-	Tile      int32 `protobuf:"varint,2,opt,name=tile,proto3" json:"tile,omitempty"`
-	TsumoGiri bool  `protobuf:"varint,3,opt,name=tsumoGiri,proto3" json:"tsumoGiri,omitempty"`
+func (x *ReadyReply) GetGetReady() *GetReadyReply {
+	if x, ok := x.GetReply().(*ReadyReply_GetReady); ok {
+		return x.GetReady
+	}
+	return nil
 }
 
-func (x *DiscardMsg) Reset() {
-	*x = DiscardMsg{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReadyReply) GetCancelReady() *CancelReadyReply {
+	if x, ok := x.GetReply().(*ReadyReply_CancelReady); ok {
+		return x.CancelReady
 	}
+	return nil
 }
 
-func (x *DiscardMsg) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ReadyReply) GetAddRobot() *AddRobotReply {
+	if x, ok := x.GetReply().(*ReadyReply_AddRobot); ok {
+		return x.AddRobot
+	}
+	return nil
 }
 
-func (*DiscardMsg) ProtoMessage() {}
+func (x *ReadyReply) GetPlayerLeave() *PlayerLeaveReply {
+	if x, ok := x.GetReply().(*ReadyReply_PlayerLeave); ok {
+		return x.PlayerLeave
+	}
+	return nil
+}
 
-func (x *DiscardMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ReadyReply) GetStartGame() *Empty {
+	if x, ok := x.GetReply().(*ReadyReply_StartGame); ok {
+		return x.StartGame
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DiscardMsg.ProtoReflect.Descriptor instead.
-func (*DiscardMsg) Descriptor() ([]byte, []int) {
-	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{23}
+func (x *ReadyReply) GetChat() *ChatReply {
+	if x, ok := x.GetReply().(*ReadyReply_Chat); ok {
+		return x.Chat
+	}
+	return nil
 }
 
-func (x *DiscardMsg) GetWho() Wind {
-	if x != nil {
-		return x.Who
+func (x *ReadyReply) GetSwapSeats() *SwapSeatsReply {
+	if x, ok := x.GetReply().(*ReadyReply_SwapSeats); ok {
+		return x.SwapSeats
 	}
-	return Wind_East
+	return nil
 }
 
-func (x *DiscardMsg) GetTile() int32 {
-	if x != nil {
-		return x.Tile
+func (x *ReadyReply) GetMoveSeat() *MoveSeatReply {
+	if x, ok := x.GetReply().(*ReadyReply_MoveSeat); ok {
+		return x.MoveSeat
 	}
-	return 0
+	return nil
 }
 
-func (x *DiscardMsg) GetTsumoGiri() bool {
-	if x != nil {
-		return x.TsumoGiri
+func (x *ReadyReply) GetKickPlayer() *KickPlayerReply {
+	if x, ok := x.GetReply().(*ReadyReply_KickPlayer); ok {
+		return x.KickPlayer
 	}
-	return false
+	return nil
 }
 
-type CallMsg struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ReadyReply) GetTransferOwner() *TransferOwnerReply {
+	if x, ok := x.GetReply().(*ReadyReply_TransferOwner); ok {
+		return x.TransferOwner
+	}
+	return nil
+}
 
-	Type        ActionType `protobuf:"varint,1,opt,name=type,proto3,enum=mahjong.ActionType" json:"type,omitempty"`
-	Who         Wind       `protobuf:"varint,2,opt,name=who,proto3,enum=mahjong.Wind" json:"who,omitempty"`
-	FromWho     *Wind      `protobuf:"varint,3,opt,name=fromWho,proto3,enum=mahjong.Wind,oneof" json:"fromWho,omitempty"`
-	TilesOnHand []int32    `protobuf:"varint,4,rep,packed,name=tilesOnHand,proto3" json:"tilesOnHand,omitempty"`
-	TileCalled  *int32     `protobuf:"varint,5,opt,name=tileCalled,proto3,oneof" json:"tileCalled,omitempty"`
+func (x *ReadyReply) GetRematch() *RematchReply {
+	if x, ok := x.GetReply().(*ReadyReply_Rematch); ok {
+		return x.Rematch
+	}
+	return nil
 }
 
-func (x *CallMsg) Reset() {
-	*x = CallMsg{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReadyReply) GetAnnouncement() *Announcement {
+	if x, ok := x.GetReply().(*ReadyReply_Announcement); ok {
+		return x.Announcement
 	}
+	return nil
 }
 
-func (x *CallMsg) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type isReadyReply_Reply interface {
+	isReadyReply_Reply()
 }
 
-func (*CallMsg) ProtoMessage() {}
+type ReadyReply_PlayerJoin struct {
+	PlayerJoin *PlayerJoinReply `protobuf:"bytes,2,opt,name=playerJoin,proto3,oneof"`
+}
 
-func (x *CallMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type ReadyReply_GetReady struct {
+	GetReady *GetReadyReply `protobuf:"bytes,3,opt,name=getReady,proto3,oneof"`
 }
 
-// Deprecated: Use CallMsg.ProtoReflect.Descriptor instead.
-func (*CallMsg) Descriptor() ([]byte, []int) {
-	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{24}
+type ReadyReply_CancelReady struct {
+	CancelReady *CancelReadyReply `protobuf:"bytes,4,opt,name=cancelReady,proto3,oneof"`
 }
 
-func (x *CallMsg) GetType() ActionType {
-	if x != nil {
-		return x.Type
-	}
-	return ActionType_Skip
+type ReadyReply_AddRobot struct {
+	AddRobot *AddRobotReply `protobuf:"bytes,5,opt,name=addRobot,proto3,oneof"`
 }
 
-func (x *CallMsg) GetWho() Wind {
-	if x != nil {
-		return x.Who
-	}
-	return Wind_East
+type ReadyReply_PlayerLeave struct {
+	PlayerLeave *PlayerLeaveReply `protobuf:"bytes,6,opt,name=playerLeave,proto3,oneof"`
 }
 
-func (x *CallMsg) GetFromWho() Wind {
-	if x != nil && x.FromWho != nil {
-		return *x.FromWho
-	}
-	return Wind_East
+type ReadyReply_StartGame struct {
+	StartGame *Empty `protobuf:"bytes,7,opt,name=startGame,proto3,oneof"`
 }
 
-func (x *CallMsg) GetTilesOnHand() []int32 {
-	if x != nil {
-		return x.TilesOnHand
-	}
-	return nil
+type ReadyReply_Chat struct {
+	Chat *ChatReply `protobuf:"bytes,8,opt,name=chat,proto3,oneof"`
 }
 
-func (x *CallMsg) GetTileCalled() int32 {
-	if x != nil && x.TileCalled != nil {
-		return *x.TileCalled
-	}
-	return 0
+type ReadyReply_SwapSeats struct {
+	SwapSeats *SwapSeatsReply `protobuf:"bytes,9,opt,name=swapSeats,proto3,oneof"`
 }
 
-type GetReadyReply struct {
+type ReadyReply_MoveSeat struct {
+	MoveSeat *MoveSeatReply `protobuf:"bytes,10,opt,name=moveSeat,proto3,oneof"`
+}
+
+type ReadyReply_KickPlayer struct {
+	KickPlayer *KickPlayerReply `protobuf:"bytes,11,opt,name=kickPlayer,proto3,oneof"`
+}
+
+type ReadyReply_TransferOwner struct {
+	TransferOwner *TransferOwnerReply `protobuf:"bytes,12,opt,name=transferOwner,proto3,oneof"`
+}
+
+type ReadyReply_Rematch struct {
+	Rematch *RematchReply `protobuf:"bytes,13,opt,name=rematch,proto3,oneof"`
+}
+
+type ReadyReply_Announcement struct {
+	Announcement *Announcement `protobuf:"bytes,14,opt,name=announcement,proto3,oneof"`
+}
+
+func (*ReadyReply_PlayerJoin) isReadyReply_Reply() {}
+
+func (*ReadyReply_GetReady) isReadyReply_Reply() {}
+
+func (*ReadyReply_CancelReady) isReadyReply_Reply() {}
+
+func (*ReadyReply_AddRobot) isReadyReply_Reply() {}
+
+func (*ReadyReply_PlayerLeave) isReadyReply_Reply() {}
+
+func (*ReadyReply_StartGame) isReadyReply_Reply() {}
+
+func (*ReadyReply_Chat) isReadyReply_Reply() {}
+
+func (*ReadyReply_SwapSeats) isReadyReply_Reply() {}
+
+func (*ReadyReply_MoveSeat) isReadyReply_Reply() {}
+
+func (*ReadyReply_KickPlayer) isReadyReply_Reply() {}
+
+func (*ReadyReply_TransferOwner) isReadyReply_Reply() {}
+
+func (*ReadyReply_Rematch) isReadyReply_Reply() {}
+
+func (*ReadyReply_Announcement) isReadyReply_Reply() {}
+
+type StartRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Seat       int32  `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
-	PlayerName string `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	// Types that are assignable to Request:
+	//
+	//	*StartRequest_Ping
+	//	*StartRequest_Action
+	//	*StartRequest_Next
+	//	*StartRequest_Chat
+	Request isStartRequest_Request `protobuf_oneof:"request"`
 }
 
-func (x *GetReadyReply) Reset() {
-	*x = GetReadyReply{}
+func (x *StartRequest) Reset() {
+	*x = StartRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1994,13 +2600,13 @@ func (x *GetReadyReply) Reset() {
 	}
 }
 
-func (x *GetReadyReply) String() string {
+func (x *StartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReadyReply) ProtoMessage() {}
+func (*StartRequest) ProtoMessage() {}
 
-func (x *GetReadyReply) ProtoReflect() protoreflect.Message {
+func (x *StartRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2012,50 +2618,111 @@ func (x *GetReadyReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReadyReply.ProtoReflect.Descriptor instead.
-func (*GetReadyReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartRequest.ProtoReflect.Descriptor instead.
+func (*StartRequest) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetReadyReply) GetSeat() int32 {
-	if x != nil {
-		return x.Seat
+func (m *StartRequest) GetRequest() isStartRequest_Request {
+	if m != nil {
+		return m.Request
 	}
-	return 0
+	return nil
 }
 
-func (x *GetReadyReply) GetPlayerName() string {
-	if x != nil {
-		return x.PlayerName
+func (x *StartRequest) GetPing() string {
+	if x, ok := x.GetRequest().(*StartRequest_Ping); ok {
+		return x.Ping
 	}
 	return ""
 }
 
-type CancelReadyReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Seat       int32  `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
-	PlayerName string `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+func (x *StartRequest) GetAction() *Action {
+	if x, ok := x.GetRequest().(*StartRequest_Action); ok {
+		return x.Action
+	}
+	return nil
 }
 
-func (x *CancelReadyReply) Reset() {
-	*x = CancelReadyReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *StartRequest) GetNext() string {
+	if x, ok := x.GetRequest().(*StartRequest_Next); ok {
+		return x.Next
 	}
+	return ""
 }
 
-func (x *CancelReadyReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StartRequest) GetChat() *ChatRequest {
+	if x, ok := x.GetRequest().(*StartRequest_Chat); ok {
+		return x.Chat
+	}
+	return nil
 }
 
-func (*CancelReadyReply) ProtoMessage() {}
+type isStartRequest_Request interface {
+	isStartRequest_Request()
+}
 
-func (x *CancelReadyReply) ProtoReflect() protoreflect.Message {
+type StartRequest_Ping struct {
+	Ping string `protobuf:"bytes,1,opt,name=ping,proto3,oneof"`
+}
+
+type StartRequest_Action struct {
+	Action *Action `protobuf:"bytes,2,opt,name=action,proto3,oneof"`
+}
+
+type StartRequest_Next struct {
+	Next string `protobuf:"bytes,3,opt,name=next,proto3,oneof"`
+}
+
+type StartRequest_Chat struct {
+	Chat *ChatRequest `protobuf:"bytes,4,opt,name=chat,proto3,oneof"`
+}
+
+func (*StartRequest_Ping) isStartRequest_Request() {}
+
+func (*StartRequest_Action) isStartRequest_Request() {}
+
+func (*StartRequest_Next) isStartRequest_Request() {}
+
+func (*StartRequest_Chat) isStartRequest_Request() {}
+
+type StartReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Types that are assignable to Reply:
+	//
+	//	*StartReply_Pong
+	//	*StartReply_Draw
+	//	*StartReply_Discard
+	//	*StartReply_Call
+	//	*StartReply_GameStart
+	//	*StartReply_GameInitInfo
+	//	*StartReply_Chat
+	//	*StartReply_SeatDraw
+	//	*StartReply_Announcement
+	Reply        isStartReply_Reply `protobuf_oneof:"reply"`
+	ValidActions []*Action          `protobuf:"bytes,4,rep,name=validActions,proto3" json:"validActions,omitempty"`
+}
+
+func (x *StartReply) Reset() {
+	*x = StartReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartReply) ProtoMessage() {}
+
+func (x *StartReply) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2067,36 +2734,163 @@ func (x *CancelReadyReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelReadyReply.ProtoReflect.Descriptor instead.
-func (*CancelReadyReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartReply.ProtoReflect.Descriptor instead.
+func (*StartReply) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CancelReadyReply) GetSeat() int32 {
+func (x *StartReply) GetMessage() string {
 	if x != nil {
-		return x.Seat
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *CancelReadyReply) GetPlayerName() string {
-	if x != nil {
-		return x.PlayerName
+func (m *StartReply) GetReply() isStartReply_Reply {
+	if m != nil {
+		return m.Reply
+	}
+	return nil
+}
+
+func (x *StartReply) GetPong() string {
+	if x, ok := x.GetReply().(*StartReply_Pong); ok {
+		return x.Pong
 	}
 	return ""
 }
 
-type AddRobotReply struct {
+func (x *StartReply) GetDraw() *DrawMsg {
+	if x, ok := x.GetReply().(*StartReply_Draw); ok {
+		return x.Draw
+	}
+	return nil
+}
+
+func (x *StartReply) GetDiscard() *DiscardMsg {
+	if x, ok := x.GetReply().(*StartReply_Discard); ok {
+		return x.Discard
+	}
+	return nil
+}
+
+func (x *StartReply) GetCall() *CallMsg {
+	if x, ok := x.GetReply().(*StartReply_Call); ok {
+		return x.Call
+	}
+	return nil
+}
+
+func (x *StartReply) GetGameStart() string {
+	if x, ok := x.GetReply().(*StartReply_GameStart); ok {
+		return x.GameStart
+	}
+	return ""
+}
+
+func (x *StartReply) GetGameInitInfo() *GameInfo {
+	if x, ok := x.GetReply().(*StartReply_GameInitInfo); ok {
+		return x.GameInitInfo
+	}
+	return nil
+}
+
+func (x *StartReply) GetChat() *ChatReply {
+	if x, ok := x.GetReply().(*StartReply_Chat); ok {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *StartReply) GetSeatDraw() *SeatDrawReply {
+	if x, ok := x.GetReply().(*StartReply_SeatDraw); ok {
+		return x.SeatDraw
+	}
+	return nil
+}
+
+func (x *StartReply) GetAnnouncement() *Announcement {
+	if x, ok := x.GetReply().(*StartReply_Announcement); ok {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *StartReply) GetValidActions() []*Action {
+	if x != nil {
+		return x.ValidActions
+	}
+	return nil
+}
+
+type isStartReply_Reply interface {
+	isStartReply_Reply()
+}
+
+type StartReply_Pong struct {
+	Pong string `protobuf:"bytes,2,opt,name=pong,proto3,oneof"`
+}
+
+type StartReply_Draw struct {
+	Draw *DrawMsg `protobuf:"bytes,3,opt,name=draw,proto3,oneof"`
+}
+
+type StartReply_Discard struct {
+	Discard *DiscardMsg `protobuf:"bytes,5,opt,name=discard,proto3,oneof"`
+}
+
+type StartReply_Call struct {
+	Call *CallMsg `protobuf:"bytes,6,opt,name=call,proto3,oneof"`
+}
+
+type StartReply_GameStart struct {
+	GameStart string `protobuf:"bytes,7,opt,name=gameStart,proto3,oneof"`
+}
+
+type StartReply_GameInitInfo struct {
+	GameInitInfo *GameInfo `protobuf:"bytes,8,opt,name=gameInitInfo,proto3,oneof"`
+}
+
+type StartReply_Chat struct {
+	Chat *ChatReply `protobuf:"bytes,9,opt,name=chat,proto3,oneof"`
+}
+
+type StartReply_SeatDraw struct {
+	SeatDraw *SeatDrawReply `protobuf:"bytes,10,opt,name=seatDraw,proto3,oneof"` // sent before gameInitInfo
+}
+
+type StartReply_Announcement struct {
+	Announcement *Announcement `protobuf:"bytes,11,opt,name=announcement,proto3,oneof"`
+}
+
+func (*StartReply_Pong) isStartReply_Reply() {}
+
+func (*StartReply_Draw) isStartReply_Reply() {}
+
+func (*StartReply_Discard) isStartReply_Reply() {}
+
+func (*StartReply_Call) isStartReply_Reply() {}
+
+func (*StartReply_GameStart) isStartReply_Reply() {}
+
+func (*StartReply_GameInitInfo) isStartReply_Reply() {}
+
+func (*StartReply_Chat) isStartReply_Reply() {}
+
+func (*StartReply_SeatDraw) isStartReply_Reply() {}
+
+func (*StartReply_Announcement) isStartReply_Reply() {}
+
+type LeaveRoomRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RobotSeat  int32  `protobuf:"varint,1,opt,name=robotSeat,proto3" json:"robotSeat,omitempty"`
-	RobotLevel string `protobuf:"bytes,2,opt,name=robotLevel,proto3" json:"robotLevel,omitempty"`
+	RoomID string `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"`
 }
 
-func (x *AddRobotReply) Reset() {
-	*x = AddRobotReply{}
+func (x *LeaveRoomRequest) Reset() {
+	*x = LeaveRoomRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2104,13 +2898,13 @@ func (x *AddRobotReply) Reset() {
 	}
 }
 
-func (x *AddRobotReply) String() string {
+func (x *LeaveRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddRobotReply) ProtoMessage() {}
+func (*LeaveRoomRequest) ProtoMessage() {}
 
-func (x *AddRobotReply) ProtoReflect() protoreflect.Message {
+func (x *LeaveRoomRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2122,36 +2916,29 @@ func (x *AddRobotReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddRobotReply.ProtoReflect.Descriptor instead.
-func (*AddRobotReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaveRoomRequest.ProtoReflect.Descriptor instead.
+func (*LeaveRoomRequest) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *AddRobotReply) GetRobotSeat() int32 {
-	if x != nil {
-		return x.RobotSeat
-	}
-	return 0
-}
-
-func (x *AddRobotReply) GetRobotLevel() string {
+func (x *LeaveRoomRequest) GetRoomID() string {
 	if x != nil {
-		return x.RobotLevel
+		return x.RoomID
 	}
 	return ""
 }
 
-type PlayerJoinReply struct {
+type AddRobotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Seat       int32  `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
-	PlayerName string `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	RobotSeat  int32  `protobuf:"varint,1,opt,name=robotSeat,proto3" json:"robotSeat,omitempty"`
+	RobotLevel string `protobuf:"bytes,2,opt,name=robotLevel,proto3" json:"robotLevel,omitempty"`
 }
 
-func (x *PlayerJoinReply) Reset() {
-	*x = PlayerJoinReply{}
+func (x *AddRobotRequest) Reset() {
+	*x = AddRobotRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2159,13 +2946,13 @@ func (x *PlayerJoinReply) Reset() {
 	}
 }
 
-func (x *PlayerJoinReply) String() string {
+func (x *AddRobotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerJoinReply) ProtoMessage() {}
+func (*AddRobotRequest) ProtoMessage() {}
 
-func (x *PlayerJoinReply) ProtoReflect() protoreflect.Message {
+func (x *AddRobotRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2177,37 +2964,35 @@ func (x *PlayerJoinReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerJoinReply.ProtoReflect.Descriptor instead.
-func (*PlayerJoinReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddRobotRequest.ProtoReflect.Descriptor instead.
+func (*AddRobotRequest) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *PlayerJoinReply) GetSeat() int32 {
+func (x *AddRobotRequest) GetRobotSeat() int32 {
 	if x != nil {
-		return x.Seat
+		return x.RobotSeat
 	}
 	return 0
 }
 
-func (x *PlayerJoinReply) GetPlayerName() string {
+func (x *AddRobotRequest) GetRobotLevel() string {
 	if x != nil {
-		return x.PlayerName
+		return x.RobotLevel
 	}
 	return ""
 }
 
-type PlayerLeaveReply struct {
+type RemovePlayerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Seat       int32  `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
-	OwnerSeat  int32  `protobuf:"varint,2,opt,name=ownerSeat,proto3" json:"ownerSeat,omitempty"`
-	PlayerName string `protobuf:"bytes,3,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	PlayerSeat int32 `protobuf:"varint,1,opt,name=playerSeat,proto3" json:"playerSeat,omitempty"`
 }
 
-func (x *PlayerLeaveReply) Reset() {
-	*x = PlayerLeaveReply{}
+func (x *RemovePlayerRequest) Reset() {
+	*x = RemovePlayerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2215,13 +3000,13 @@ func (x *PlayerLeaveReply) Reset() {
 	}
 }
 
-func (x *PlayerLeaveReply) String() string {
+func (x *RemovePlayerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerLeaveReply) ProtoMessage() {}
+func (*RemovePlayerRequest) ProtoMessage() {}
 
-func (x *PlayerLeaveReply) ProtoReflect() protoreflect.Message {
+func (x *RemovePlayerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2233,557 +3018,6813 @@ func (x *PlayerLeaveReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerLeaveReply.ProtoReflect.Descriptor instead.
-func (*PlayerLeaveReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemovePlayerRequest.ProtoReflect.Descriptor instead.
+func (*RemovePlayerRequest) Descriptor() ([]byte, []int) {
 	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *PlayerLeaveReply) GetSeat() int32 {
+func (x *RemovePlayerRequest) GetPlayerSeat() int32 {
+	if x != nil {
+		return x.PlayerSeat
+	}
+	return 0
+}
+
+type SwapSeatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SeatA int32 `protobuf:"varint,1,opt,name=seatA,proto3" json:"seatA,omitempty"`
+	SeatB int32 `protobuf:"varint,2,opt,name=seatB,proto3" json:"seatB,omitempty"`
+}
+
+func (x *SwapSeatsRequest) Reset() {
+	*x = SwapSeatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapSeatsRequest) ProtoMessage() {}
+
+func (x *SwapSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapSeatsRequest.ProtoReflect.Descriptor instead.
+func (*SwapSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SwapSeatsRequest) GetSeatA() int32 {
+	if x != nil {
+		return x.SeatA
+	}
+	return 0
+}
+
+func (x *SwapSeatsRequest) GetSeatB() int32 {
+	if x != nil {
+		return x.SeatB
+	}
+	return 0
+}
+
+type MoveSeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat int32 `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"` // an idle seat
+}
+
+func (x *MoveSeatRequest) Reset() {
+	*x = MoveSeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveSeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveSeatRequest) ProtoMessage() {}
+
+func (x *MoveSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveSeatRequest.ProtoReflect.Descriptor instead.
+func (*MoveSeatRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MoveSeatRequest) GetSeat() int32 {
 	if x != nil {
 		return x.Seat
 	}
 	return 0
 }
 
-func (x *PlayerLeaveReply) GetOwnerSeat() int32 {
+type KickPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerSeat int32 `protobuf:"varint,1,opt,name=playerSeat,proto3" json:"playerSeat,omitempty"`
+	Ban        bool  `protobuf:"varint,2,opt,name=ban,proto3" json:"ban,omitempty"` // the player can't join the room again
+}
+
+func (x *KickPlayerRequest) Reset() {
+	*x = KickPlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickPlayerRequest) ProtoMessage() {}
+
+func (x *KickPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickPlayerRequest.ProtoReflect.Descriptor instead.
+func (*KickPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *KickPlayerRequest) GetPlayerSeat() int32 {
 	if x != nil {
-		return x.OwnerSeat
+		return x.PlayerSeat
 	}
 	return 0
 }
 
-func (x *PlayerLeaveReply) GetPlayerName() string {
+func (x *KickPlayerRequest) GetBan() bool {
 	if x != nil {
-		return x.PlayerName
+		return x.Ban
 	}
-	return ""
+	return false
 }
 
-type ChatRequest struct {
+type TransferOwnerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Seat int32 `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
 }
 
-func (x *ChatRequest) Reset() {
-	*x = ChatRequest{}
+func (x *TransferOwnerRequest) Reset() {
+	*x = TransferOwnerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[30]
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChatRequest) String() string {
+func (x *TransferOwnerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatRequest) ProtoMessage() {}
+func (*TransferOwnerRequest) ProtoMessage() {}
 
-func (x *ChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[30]
+func (x *TransferOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnerRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TransferOwnerRequest) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+type RematchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accept bool `protobuf:"varint,1,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (x *RematchRequest) Reset() {
+	*x = RematchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RematchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RematchRequest) ProtoMessage() {}
+
+func (x *RematchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RematchRequest.ProtoReflect.Descriptor instead.
+func (*RematchRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RematchRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type Action struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    ActionType `protobuf:"varint,1,opt,name=type,proto3,enum=mahjong.ActionType" json:"type,omitempty"`
+	Tiles   []int32    `protobuf:"varint,2,rep,packed,name=tiles,proto3" json:"tiles,omitempty"`
+	FromWho []Wind     `protobuf:"varint,3,rep,packed,name=fromWho,proto3,enum=mahjong.Wind" json:"fromWho,omitempty"`
+}
+
+func (x *Action) Reset() {
+	*x = Action{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Action) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Action) ProtoMessage() {}
+
+func (x *Action) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ChatRequest.ProtoReflect.Descriptor instead.
-func (*ChatRequest) Descriptor() ([]byte, []int) {
-	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *ChatRequest) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-type ChatReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Message    string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	PlayerName string `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
-}
-
-func (x *ChatReply) Reset() {
-	*x = ChatReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ChatReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ChatReply) ProtoMessage() {}
-
-func (x *ChatReply) ProtoReflect() protoreflect.Message {
-	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Action.ProtoReflect.Descriptor instead.
+func (*Action) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Action) GetType() ActionType {
+	if x != nil {
+		return x.Type
+	}
+	return ActionType_Skip
+}
+
+func (x *Action) GetTiles() []int32 {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+func (x *Action) GetFromWho() []Wind {
+	if x != nil {
+		return x.FromWho
+	}
+	return nil
+}
+
+type GameInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Wind        Wind    `protobuf:"varint,1,opt,name=wind,proto3,enum=mahjong.Wind" json:"wind,omitempty"`
+	Dora        []int32 `protobuf:"varint,2,rep,packed,name=dora,proto3" json:"dora,omitempty"`
+	Tiles       []int32 `protobuf:"varint,3,rep,packed,name=tiles,proto3" json:"tiles,omitempty"`
+	WindRound   int32   `protobuf:"varint,4,opt,name=windRound,proto3" json:"windRound,omitempty"` // 东一、二、三、四；南一、二、三、四...
+	RoundNumber int32   `protobuf:"varint,5,opt,name=roundNumber,proto3" json:"roundNumber,omitempty"`
+	RiichiNum   int32   `protobuf:"varint,6,opt,name=riichiNum,proto3" json:"riichiNum,omitempty"` // 立直棒个数
+	HonbaNum    int32   `protobuf:"varint,7,opt,name=honbaNum,proto3" json:"honbaNum,omitempty"`   // 本场数
+}
+
+func (x *GameInfo) Reset() {
+	*x = GameInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameInfo) ProtoMessage() {}
+
+func (x *GameInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameInfo.ProtoReflect.Descriptor instead.
+func (*GameInfo) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GameInfo) GetWind() Wind {
+	if x != nil {
+		return x.Wind
+	}
+	return Wind_East
+}
+
+func (x *GameInfo) GetDora() []int32 {
+	if x != nil {
+		return x.Dora
+	}
+	return nil
+}
+
+func (x *GameInfo) GetTiles() []int32 {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+func (x *GameInfo) GetWindRound() int32 {
+	if x != nil {
+		return x.WindRound
+	}
+	return 0
+}
+
+func (x *GameInfo) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *GameInfo) GetRiichiNum() int32 {
+	if x != nil {
+		return x.RiichiNum
+	}
+	return 0
+}
+
+func (x *GameInfo) GetHonbaNum() int32 {
+	if x != nil {
+		return x.HonbaNum
+	}
+	return 0
+}
+
+type DrawMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Who  Wind   `protobuf:"varint,1,opt,name=who,proto3,enum=mahjong.Wind" json:"who,omitempty"`
+	Tile *int32 `protobuf:"varint,2,opt,name=tile,proto3,oneof" json:"tile,omitempty"`
+}
+
+func (x *DrawMsg) Reset() {
+	*x = DrawMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawMsg) ProtoMessage() {}
+
+func (x *DrawMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawMsg.ProtoReflect.Descriptor instead.
+func (*DrawMsg) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DrawMsg) GetWho() Wind {
+	if x != nil {
+		return x.Who
+	}
+	return Wind_East
+}
+
+func (x *DrawMsg) GetTile() int32 {
+	if x != nil && x.Tile != nil {
+		return *x.Tile
+	}
+	return 0
+}
+
+type DiscardMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Who       Wind  `protobuf:"varint,1,opt,name=who,proto3,enum=mahjong.Wind" json:"who,omitempty"`
+	Tile      int32 `protobuf:"varint,2,opt,name=tile,proto3" json:"tile,omitempty"`
+	TsumoGiri bool  `protobuf:"varint,3,opt,name=tsumoGiri,proto3" json:"tsumoGiri,omitempty"`
+}
+
+func (x *DiscardMsg) Reset() {
+	*x = DiscardMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscardMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardMsg) ProtoMessage() {}
+
+func (x *DiscardMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardMsg.ProtoReflect.Descriptor instead.
+func (*DiscardMsg) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DiscardMsg) GetWho() Wind {
+	if x != nil {
+		return x.Who
+	}
+	return Wind_East
+}
+
+func (x *DiscardMsg) GetTile() int32 {
+	if x != nil {
+		return x.Tile
+	}
+	return 0
+}
+
+func (x *DiscardMsg) GetTsumoGiri() bool {
+	if x != nil {
+		return x.TsumoGiri
+	}
+	return false
+}
+
+type CallMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        ActionType `protobuf:"varint,1,opt,name=type,proto3,enum=mahjong.ActionType" json:"type,omitempty"`
+	Who         Wind       `protobuf:"varint,2,opt,name=who,proto3,enum=mahjong.Wind" json:"who,omitempty"`
+	FromWho     *Wind      `protobuf:"varint,3,opt,name=fromWho,proto3,enum=mahjong.Wind,oneof" json:"fromWho,omitempty"`
+	TilesOnHand []int32    `protobuf:"varint,4,rep,packed,name=tilesOnHand,proto3" json:"tilesOnHand,omitempty"`
+	TileCalled  *int32     `protobuf:"varint,5,opt,name=tileCalled,proto3,oneof" json:"tileCalled,omitempty"`
+}
+
+func (x *CallMsg) Reset() {
+	*x = CallMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallMsg) ProtoMessage() {}
+
+func (x *CallMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallMsg.ProtoReflect.Descriptor instead.
+func (*CallMsg) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CallMsg) GetType() ActionType {
+	if x != nil {
+		return x.Type
+	}
+	return ActionType_Skip
+}
+
+func (x *CallMsg) GetWho() Wind {
+	if x != nil {
+		return x.Who
+	}
+	return Wind_East
+}
+
+func (x *CallMsg) GetFromWho() Wind {
+	if x != nil && x.FromWho != nil {
+		return *x.FromWho
+	}
+	return Wind_East
+}
+
+func (x *CallMsg) GetTilesOnHand() []int32 {
+	if x != nil {
+		return x.TilesOnHand
+	}
+	return nil
+}
+
+func (x *CallMsg) GetTileCalled() int32 {
+	if x != nil && x.TileCalled != nil {
+		return *x.TileCalled
+	}
+	return 0
+}
+
+type GetReadyReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat       int32  `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+}
+
+func (x *GetReadyReply) Reset() {
+	*x = GetReadyReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReadyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadyReply) ProtoMessage() {}
+
+func (x *GetReadyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadyReply.ProtoReflect.Descriptor instead.
+func (*GetReadyReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetReadyReply) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *GetReadyReply) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+type CancelReadyReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat       int32  `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+}
+
+func (x *CancelReadyReply) Reset() {
+	*x = CancelReadyReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelReadyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReadyReply) ProtoMessage() {}
+
+func (x *CancelReadyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReadyReply.ProtoReflect.Descriptor instead.
+func (*CancelReadyReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CancelReadyReply) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *CancelReadyReply) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+type AddRobotReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RobotSeat  int32  `protobuf:"varint,1,opt,name=robotSeat,proto3" json:"robotSeat,omitempty"`
+	RobotLevel string `protobuf:"bytes,2,opt,name=robotLevel,proto3" json:"robotLevel,omitempty"`
+}
+
+func (x *AddRobotReply) Reset() {
+	*x = AddRobotReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddRobotReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRobotReply) ProtoMessage() {}
+
+func (x *AddRobotReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRobotReply.ProtoReflect.Descriptor instead.
+func (*AddRobotReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AddRobotReply) GetRobotSeat() int32 {
+	if x != nil {
+		return x.RobotSeat
+	}
+	return 0
+}
+
+func (x *AddRobotReply) GetRobotLevel() string {
+	if x != nil {
+		return x.RobotLevel
+	}
+	return ""
+}
+
+type PlayerJoinReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat       int32  `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Rank       string `protobuf:"bytes,3,opt,name=rank,proto3" json:"rank,omitempty"`
+}
+
+func (x *PlayerJoinReply) Reset() {
+	*x = PlayerJoinReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerJoinReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerJoinReply) ProtoMessage() {}
+
+func (x *PlayerJoinReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerJoinReply.ProtoReflect.Descriptor instead.
+func (*PlayerJoinReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PlayerJoinReply) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *PlayerJoinReply) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *PlayerJoinReply) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+type PlayerLeaveReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat       int32  `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	OwnerSeat  int32  `protobuf:"varint,2,opt,name=ownerSeat,proto3" json:"ownerSeat,omitempty"`
+	PlayerName string `protobuf:"bytes,3,opt,name=playerName,proto3" json:"playerName,omitempty"`
+}
+
+func (x *PlayerLeaveReply) Reset() {
+	*x = PlayerLeaveReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerLeaveReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerLeaveReply) ProtoMessage() {}
+
+func (x *PlayerLeaveReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerLeaveReply.ProtoReflect.Descriptor instead.
+func (*PlayerLeaveReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PlayerLeaveReply) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *PlayerLeaveReply) GetOwnerSeat() int32 {
+	if x != nil {
+		return x.OwnerSeat
+	}
+	return 0
+}
+
+func (x *PlayerLeaveReply) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+type ChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ChatRequest) Reset() {
+	*x = ChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatRequest) ProtoMessage() {}
+
+func (x *ChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatRequest.ProtoReflect.Descriptor instead.
+func (*ChatRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ChatRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ChatReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message    string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+}
+
+func (x *ChatReply) Reset() {
+	*x = ChatReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatReply) ProtoMessage() {}
+
+func (x *ChatReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatReply.ProtoReflect.Descriptor instead.
+func (*ChatReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ChatReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ChatReply) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+type SwapSeatsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SeatA int32 `protobuf:"varint,1,opt,name=seatA,proto3" json:"seatA,omitempty"`
+	SeatB int32 `protobuf:"varint,2,opt,name=seatB,proto3" json:"seatB,omitempty"`
+}
+
+func (x *SwapSeatsReply) Reset() {
+	*x = SwapSeatsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapSeatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapSeatsReply) ProtoMessage() {}
+
+func (x *SwapSeatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapSeatsReply.ProtoReflect.Descriptor instead.
+func (*SwapSeatsReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SwapSeatsReply) GetSeatA() int32 {
+	if x != nil {
+		return x.SeatA
+	}
+	return 0
+}
+
+func (x *SwapSeatsReply) GetSeatB() int32 {
+	if x != nil {
+		return x.SeatB
+	}
+	return 0
+}
+
+type MoveSeatReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromSeat   int32  `protobuf:"varint,1,opt,name=fromSeat,proto3" json:"fromSeat,omitempty"`
+	ToSeat     int32  `protobuf:"varint,2,opt,name=toSeat,proto3" json:"toSeat,omitempty"`
+	PlayerName string `protobuf:"bytes,3,opt,name=playerName,proto3" json:"playerName,omitempty"`
+}
+
+func (x *MoveSeatReply) Reset() {
+	*x = MoveSeatReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveSeatReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveSeatReply) ProtoMessage() {}
+
+func (x *MoveSeatReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveSeatReply.ProtoReflect.Descriptor instead.
+func (*MoveSeatReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MoveSeatReply) GetFromSeat() int32 {
+	if x != nil {
+		return x.FromSeat
+	}
+	return 0
+}
+
+func (x *MoveSeatReply) GetToSeat() int32 {
+	if x != nil {
+		return x.ToSeat
+	}
+	return 0
+}
+
+func (x *MoveSeatReply) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+type KickPlayerReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat       int32  `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Banned     bool   `protobuf:"varint,3,opt,name=banned,proto3" json:"banned,omitempty"`
+}
+
+func (x *KickPlayerReply) Reset() {
+	*x = KickPlayerReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickPlayerReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickPlayerReply) ProtoMessage() {}
+
+func (x *KickPlayerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickPlayerReply.ProtoReflect.Descriptor instead.
+func (*KickPlayerReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *KickPlayerReply) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *KickPlayerReply) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *KickPlayerReply) GetBanned() bool {
+	if x != nil {
+		return x.Banned
+	}
+	return false
+}
+
+type TransferOwnerReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerSeat int32  `protobuf:"varint,1,opt,name=ownerSeat,proto3" json:"ownerSeat,omitempty"`
+	OwnerName string `protobuf:"bytes,2,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+}
+
+func (x *TransferOwnerReply) Reset() {
+	*x = TransferOwnerReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferOwnerReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnerReply) ProtoMessage() {}
+
+func (x *TransferOwnerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnerReply.ProtoReflect.Descriptor instead.
+func (*TransferOwnerReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TransferOwnerReply) GetOwnerSeat() int32 {
+	if x != nil {
+		return x.OwnerSeat
+	}
+	return 0
+}
+
+func (x *TransferOwnerReply) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+type RematchReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat       int32  `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Accept     bool   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+	Votes      int32  `protobuf:"varint,4,opt,name=votes,proto3" json:"votes,omitempty"`   // players that accepted, robots always accept
+	Needed     int32  `protobuf:"varint,5,opt,name=needed,proto3" json:"needed,omitempty"` // players seated in the room
+}
+
+func (x *RematchReply) Reset() {
+	*x = RematchReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RematchReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RematchReply) ProtoMessage() {}
+
+func (x *RematchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RematchReply.ProtoReflect.Descriptor instead.
+func (*RematchReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RematchReply) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *RematchReply) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *RematchReply) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+func (x *RematchReply) GetVotes() int32 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *RematchReply) GetNeeded() int32 {
+	if x != nil {
+		return x.Needed
+	}
+	return 0
+}
+
+type PlayerProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerName       string  `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	CreatedAt        int64   `protobuf:"varint,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`     // unix seconds
+	LastLoginAt      int64   `protobuf:"varint,3,opt,name=lastLoginAt,proto3" json:"lastLoginAt,omitempty"` // unix seconds
+	MatchCount       int32   `protobuf:"varint,4,opt,name=matchCount,proto3" json:"matchCount,omitempty"`
+	TotalScore       int64   `protobuf:"varint,5,opt,name=totalScore,proto3" json:"totalScore,omitempty"`
+	Placements       []int32 `protobuf:"varint,6,rep,packed,name=placements,proto3" json:"placements,omitempty"` // times finished 1st, 2nd, 3rd and 4th
+	AveragePlacement float64 `protobuf:"fixed64,7,opt,name=averagePlacement,proto3" json:"averagePlacement,omitempty"`
+}
+
+func (x *PlayerProfile) Reset() {
+	*x = PlayerProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerProfile) ProtoMessage() {}
+
+func (x *PlayerProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerProfile.ProtoReflect.Descriptor instead.
+func (*PlayerProfile) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PlayerProfile) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *PlayerProfile) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetLastLoginAt() int64 {
+	if x != nil {
+		return x.LastLoginAt
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetMatchCount() int32 {
+	if x != nil {
+		return x.MatchCount
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetTotalScore() int64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetPlacements() []int32 {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+func (x *PlayerProfile) GetAveragePlacement() float64 {
+	if x != nil {
+		return x.AveragePlacement
+	}
+	return 0
+}
+
+type GetProfileReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string         `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Profile *PlayerProfile `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *GetProfileReply) Reset() {
+	*x = GetProfileReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProfileReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileReply) ProtoMessage() {}
+
+func (x *GetProfileReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileReply.ProtoReflect.Descriptor instead.
+func (*GetProfileReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetProfileReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetProfileReply) GetProfile() *PlayerProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type MatchPlayerResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerName string `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Seat       int32  `protobuf:"varint,2,opt,name=seat,proto3" json:"seat,omitempty"`
+	Robot      bool   `protobuf:"varint,3,opt,name=robot,proto3" json:"robot,omitempty"`
+	Score      int32  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	Placement  int32  `protobuf:"varint,5,opt,name=placement,proto3" json:"placement,omitempty"`
+}
+
+func (x *MatchPlayerResult) Reset() {
+	*x = MatchPlayerResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchPlayerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchPlayerResult) ProtoMessage() {}
+
+func (x *MatchPlayerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchPlayerResult.ProtoReflect.Descriptor instead.
+func (*MatchPlayerResult) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MatchPlayerResult) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *MatchPlayerResult) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *MatchPlayerResult) GetRobot() bool {
+	if x != nil {
+		return x.Robot
+	}
+	return false
+}
+
+func (x *MatchPlayerResult) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *MatchPlayerResult) GetPlacement() int32 {
+	if x != nil {
+		return x.Placement
+	}
+	return 0
+}
+
+type MatchRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MatchID    string               `protobuf:"bytes,1,opt,name=matchID,proto3" json:"matchID,omitempty"`
+	RoomName   string               `protobuf:"bytes,2,opt,name=roomName,proto3" json:"roomName,omitempty"`
+	RuleSet    string               `protobuf:"bytes,3,opt,name=ruleSet,proto3" json:"ruleSet,omitempty"`
+	StartedAt  int64                `protobuf:"varint,4,opt,name=startedAt,proto3" json:"startedAt,omitempty"`   // unix seconds
+	FinishedAt int64                `protobuf:"varint,5,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"` // unix seconds
+	Players    []*MatchPlayerResult `protobuf:"bytes,6,rep,name=players,proto3" json:"players,omitempty"`
+	ReplayRef  string               `protobuf:"bytes,7,opt,name=replayRef,proto3" json:"replayRef,omitempty"`
+}
+
+func (x *MatchRecord) Reset() {
+	*x = MatchRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchRecord) ProtoMessage() {}
+
+func (x *MatchRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchRecord.ProtoReflect.Descriptor instead.
+func (*MatchRecord) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MatchRecord) GetMatchID() string {
+	if x != nil {
+		return x.MatchID
+	}
+	return ""
+}
+
+func (x *MatchRecord) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *MatchRecord) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *MatchRecord) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *MatchRecord) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *MatchRecord) GetPlayers() []*MatchPlayerResult {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *MatchRecord) GetReplayRef() string {
+	if x != nil {
+		return x.ReplayRef
+	}
+	return ""
+}
+
+type GetMatchHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // 0 means all
+}
+
+func (x *GetMatchHistoryRequest) Reset() {
+	*x = GetMatchHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMatchHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchHistoryRequest) ProtoMessage() {}
+
+func (x *GetMatchHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMatchHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetMatchHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetMatchHistoryReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string         `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Matches []*MatchRecord `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"`
+}
+
+func (x *GetMatchHistoryReply) Reset() {
+	*x = GetMatchHistoryReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMatchHistoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchHistoryReply) ProtoMessage() {}
+
+func (x *GetMatchHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchHistoryReply.ProtoReflect.Descriptor instead.
+func (*GetMatchHistoryReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetMatchHistoryReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetMatchHistoryReply) GetMatches() []*MatchRecord {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type GetPlayerStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerName *string `protobuf:"bytes,1,opt,name=playerName,proto3,oneof" json:"playerName,omitempty"` // defaults to the caller
+}
+
+func (x *GetPlayerStatsRequest) Reset() {
+	*x = GetPlayerStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerStatsRequest) ProtoMessage() {}
+
+func (x *GetPlayerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetPlayerStatsRequest) GetPlayerName() string {
+	if x != nil && x.PlayerName != nil {
+		return *x.PlayerName
+	}
+	return ""
+}
+
+type PlayerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerName       string  `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	RatingSystem     string  `protobuf:"bytes,2,opt,name=ratingSystem,proto3" json:"ratingSystem,omitempty"`
+	Rank             string  `protobuf:"bytes,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Rating           float64 `protobuf:"fixed64,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	RankPoints       int32   `protobuf:"varint,5,opt,name=rankPoints,proto3" json:"rankPoints,omitempty"`
+	MatchCount       int32   `protobuf:"varint,6,opt,name=matchCount,proto3" json:"matchCount,omitempty"`
+	HandCount        int32   `protobuf:"varint,7,opt,name=handCount,proto3" json:"handCount,omitempty"`
+	WinRate          float64 `protobuf:"fixed64,8,opt,name=winRate,proto3" json:"winRate,omitempty"`
+	DealInRate       float64 `protobuf:"fixed64,9,opt,name=dealInRate,proto3" json:"dealInRate,omitempty"`
+	RiichiRate       float64 `protobuf:"fixed64,10,opt,name=riichiRate,proto3" json:"riichiRate,omitempty"`
+	CallRate         float64 `protobuf:"fixed64,11,opt,name=callRate,proto3" json:"callRate,omitempty"`
+	AveragePlacement float64 `protobuf:"fixed64,12,opt,name=averagePlacement,proto3" json:"averagePlacement,omitempty"`
+	AverageWinPoints float64 `protobuf:"fixed64,13,opt,name=averageWinPoints,proto3" json:"averageWinPoints,omitempty"`
+}
+
+func (x *PlayerStats) Reset() {
+	*x = PlayerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats) ProtoMessage() {}
+
+func (x *PlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PlayerStats) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *PlayerStats) GetRatingSystem() string {
+	if x != nil {
+		return x.RatingSystem
+	}
+	return ""
+}
+
+func (x *PlayerStats) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *PlayerStats) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetRankPoints() int32 {
+	if x != nil {
+		return x.RankPoints
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetMatchCount() int32 {
+	if x != nil {
+		return x.MatchCount
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetHandCount() int32 {
+	if x != nil {
+		return x.HandCount
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetWinRate() float64 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetDealInRate() float64 {
+	if x != nil {
+		return x.DealInRate
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetRiichiRate() float64 {
+	if x != nil {
+		return x.RiichiRate
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetCallRate() float64 {
+	if x != nil {
+		return x.CallRate
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAveragePlacement() float64 {
+	if x != nil {
+		return x.AveragePlacement
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAverageWinPoints() float64 {
+	if x != nil {
+		return x.AverageWinPoints
+	}
+	return 0
+}
+
+type GetPlayerStatsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string       `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Stats   *PlayerStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GetPlayerStatsReply) Reset() {
+	*x = GetPlayerStatsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerStatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerStatsReply) ProtoMessage() {}
+
+func (x *GetPlayerStatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerStatsReply.ProtoReflect.Descriptor instead.
+func (*GetPlayerStatsReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetPlayerStatsReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetPlayerStatsReply) GetStats() *PlayerStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type GetLeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderBy    LeaderboardOrder `protobuf:"varint,1,opt,name=orderBy,proto3,enum=mahjong.LeaderboardOrder" json:"orderBy,omitempty"`
+	Window     TimeWindow       `protobuf:"varint,2,opt,name=window,proto3,enum=mahjong.TimeWindow" json:"window,omitempty"`
+	RuleSet    *string          `protobuf:"bytes,3,opt,name=ruleSet,proto3,oneof" json:"ruleSet,omitempty"`
+	MinMatches int32            `protobuf:"varint,4,opt,name=minMatches,proto3" json:"minMatches,omitempty"`
+	PageSize   int32            `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`  // defaults to 20
+	PageToken  string           `protobuf:"bytes,6,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // nextPageToken of the previous page
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetLeaderboardRequest) GetOrderBy() LeaderboardOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return LeaderboardOrder_ByRating
+}
+
+func (x *GetLeaderboardRequest) GetWindow() TimeWindow {
+	if x != nil {
+		return x.Window
+	}
+	return TimeWindow_AllTime
+}
+
+func (x *GetLeaderboardRequest) GetRuleSet() string {
+	if x != nil && x.RuleSet != nil {
+		return *x.RuleSet
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetMinMatches() int32 {
+	if x != nil {
+		return x.MinMatches
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type LeaderboardEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position         int32   `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	PlayerName       string  `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Rank             string  `protobuf:"bytes,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Rating           float64 `protobuf:"fixed64,4,opt,name=rating,proto3" json:"rating,omitempty"` // value the rating system orders players by
+	MatchCount       int32   `protobuf:"varint,5,opt,name=matchCount,proto3" json:"matchCount,omitempty"`
+	AveragePlacement float64 `protobuf:"fixed64,6,opt,name=averagePlacement,proto3" json:"averagePlacement,omitempty"`
+	TotalScore       int64   `protobuf:"varint,7,opt,name=totalScore,proto3" json:"totalScore,omitempty"`
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *LeaderboardEntry) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetMatchCount() int32 {
+	if x != nil {
+		return x.MatchCount
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetAveragePlacement() float64 {
+	if x != nil {
+		return x.AveragePlacement
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetTotalScore() int64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+type GetLeaderboardReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message       string              `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Entries       []*LeaderboardEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string              `protobuf:"bytes,3,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // empty on the last page
+}
+
+func (x *GetLeaderboardReply) Reset() {
+	*x = GetLeaderboardReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaderboardReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardReply) ProtoMessage() {}
+
+func (x *GetLeaderboardReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardReply.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetLeaderboardReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetLeaderboardReply) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetLeaderboardReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type JoinQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleSet     *string `protobuf:"bytes,1,opt,name=ruleSet,proto3,oneof" json:"ruleSet,omitempty"`
+	AllowRobots bool    `protobuf:"varint,2,opt,name=allowRobots,proto3" json:"allowRobots,omitempty"` // robots may fill the table after the queue timeout
+}
+
+func (x *JoinQueueRequest) Reset() {
+	*x = JoinQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinQueueRequest) ProtoMessage() {}
+
+func (x *JoinQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinQueueRequest.ProtoReflect.Descriptor instead.
+func (*JoinQueueRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *JoinQueueRequest) GetRuleSet() string {
+	if x != nil && x.RuleSet != nil {
+		return *x.RuleSet
+	}
+	return ""
+}
+
+func (x *JoinQueueRequest) GetAllowRobots() bool {
+	if x != nil {
+		return x.AllowRobots
+	}
+	return false
+}
+
+type QueueStatusReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WaitingPlayers int32   `protobuf:"varint,1,opt,name=waitingPlayers,proto3" json:"waitingPlayers,omitempty"` // players waiting for the same rule set
+	WaitedSeconds  int64   `protobuf:"varint,2,opt,name=waitedSeconds,proto3" json:"waitedSeconds,omitempty"`
+	Band           float64 `protobuf:"fixed64,3,opt,name=band,proto3" json:"band,omitempty"` // rating distance accepted now
+}
+
+func (x *QueueStatusReply) Reset() {
+	*x = QueueStatusReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueStatusReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueStatusReply) ProtoMessage() {}
+
+func (x *QueueStatusReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueStatusReply.ProtoReflect.Descriptor instead.
+func (*QueueStatusReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *QueueStatusReply) GetWaitingPlayers() int32 {
+	if x != nil {
+		return x.WaitingPlayers
+	}
+	return 0
+}
+
+func (x *QueueStatusReply) GetWaitedSeconds() int64 {
+	if x != nil {
+		return x.WaitedSeconds
+	}
+	return 0
+}
+
+func (x *QueueStatusReply) GetBand() float64 {
+	if x != nil {
+		return x.Band
+	}
+	return 0
+}
+
+type QueueMatchedReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Room *Room `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	Seat int32 `protobuf:"varint,2,opt,name=seat,proto3" json:"seat,omitempty"`
+}
+
+func (x *QueueMatchedReply) Reset() {
+	*x = QueueMatchedReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueMatchedReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueMatchedReply) ProtoMessage() {}
+
+func (x *QueueMatchedReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueMatchedReply.ProtoReflect.Descriptor instead.
+func (*QueueMatchedReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *QueueMatchedReply) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *QueueMatchedReply) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+type QueueReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Types that are assignable to Reply:
+	//
+	//	*QueueReply_Status
+	//	*QueueReply_Matched
+	//	*QueueReply_Left
+	Reply isQueueReply_Reply `protobuf_oneof:"reply"`
+}
+
+func (x *QueueReply) Reset() {
+	*x = QueueReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueReply) ProtoMessage() {}
+
+func (x *QueueReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueReply.ProtoReflect.Descriptor instead.
+func (*QueueReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *QueueReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (m *QueueReply) GetReply() isQueueReply_Reply {
+	if m != nil {
+		return m.Reply
+	}
+	return nil
+}
+
+func (x *QueueReply) GetStatus() *QueueStatusReply {
+	if x, ok := x.GetReply().(*QueueReply_Status); ok {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *QueueReply) GetMatched() *QueueMatchedReply {
+	if x, ok := x.GetReply().(*QueueReply_Matched); ok {
+		return x.Matched
+	}
+	return nil
+}
+
+func (x *QueueReply) GetLeft() *Empty {
+	if x, ok := x.GetReply().(*QueueReply_Left); ok {
+		return x.Left
+	}
+	return nil
+}
+
+type isQueueReply_Reply interface {
+	isQueueReply_Reply()
+}
+
+type QueueReply_Status struct {
+	Status *QueueStatusReply `protobuf:"bytes,2,opt,name=status,proto3,oneof"`
+}
+
+type QueueReply_Matched struct {
+	Matched *QueueMatchedReply `protobuf:"bytes,3,opt,name=matched,proto3,oneof"`
+}
+
+type QueueReply_Left struct {
+	Left *Empty `protobuf:"bytes,4,opt,name=left,proto3,oneof"`
+}
+
+func (*QueueReply_Status) isQueueReply_Reply() {}
+
+func (*QueueReply_Matched) isQueueReply_Reply() {}
+
+func (*QueueReply_Left) isQueueReply_Reply() {}
+
+type LeaveQueueReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *LeaveQueueReply) Reset() {
+	*x = LeaveQueueReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveQueueReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveQueueReply) ProtoMessage() {}
+
+func (x *LeaveQueueReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveQueueReply.ProtoReflect.Descriptor instead.
+func (*LeaveQueueReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *LeaveQueueReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SeatAssignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerName string `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	FromSeat   int32  `protobuf:"varint,2,opt,name=fromSeat,proto3" json:"fromSeat,omitempty"`
+	Seat       int32  `protobuf:"varint,3,opt,name=seat,proto3" json:"seat,omitempty"`
+	Wind       Wind   `protobuf:"varint,4,opt,name=wind,proto3,enum=mahjong.Wind" json:"wind,omitempty"` // seat wind, the drawn tile for WindDraw
+	Order      int32  `protobuf:"varint,5,opt,name=order,proto3" json:"order,omitempty"`                 // when the player drew its tile in WindDraw, 1 is first, 0 for the other modes
+}
+
+func (x *SeatAssignment) Reset() {
+	*x = SeatAssignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatAssignment) ProtoMessage() {}
+
+func (x *SeatAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatAssignment.ProtoReflect.Descriptor instead.
+func (*SeatAssignment) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SeatAssignment) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *SeatAssignment) GetFromSeat() int32 {
+	if x != nil {
+		return x.FromSeat
+	}
+	return 0
+}
+
+func (x *SeatAssignment) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *SeatAssignment) GetWind() Wind {
+	if x != nil {
+		return x.Wind
+	}
+	return Wind_East
+}
+
+func (x *SeatAssignment) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+type SeatDrawReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode  SeatDrawMode      `protobuf:"varint,1,opt,name=mode,proto3,enum=mahjong.SeatDrawMode" json:"mode,omitempty"`
+	Seed  int64             `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"` // replaying the draw with the seed gives the same seats
+	Seats []*SeatAssignment `protobuf:"bytes,3,rep,name=seats,proto3" json:"seats,omitempty"`
+	Dice  []int32           `protobuf:"varint,4,rep,packed,name=dice,proto3" json:"dice,omitempty"` // the two dice rolled by the temporary east in WindDraw, empty for the other modes
+}
+
+func (x *SeatDrawReply) Reset() {
+	*x = SeatDrawReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatDrawReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatDrawReply) ProtoMessage() {}
+
+func (x *SeatDrawReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatDrawReply.ProtoReflect.Descriptor instead.
+func (*SeatDrawReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SeatDrawReply) GetMode() SeatDrawMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SeatDrawMode_NoDraw
+}
+
+func (x *SeatDrawReply) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *SeatDrawReply) GetSeats() []*SeatAssignment {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *SeatDrawReply) GetDice() []int32 {
+	if x != nil {
+		return x.Dice
+	}
+	return nil
+}
+
+type SessionScoring struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartPoints  int32     `protobuf:"varint,1,opt,name=startPoints,proto3" json:"startPoints,omitempty"`
+	ReturnPoints int32     `protobuf:"varint,2,opt,name=returnPoints,proto3" json:"returnPoints,omitempty"` // the difference of the four players goes to 1st as oka
+	Uma          []float64 `protobuf:"fixed64,3,rep,packed,name=uma,proto3" json:"uma,omitempty"`           // for 1st to 4th, in thousands of points
+}
+
+func (x *SessionScoring) Reset() {
+	*x = SessionScoring{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionScoring) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionScoring) ProtoMessage() {}
+
+func (x *SessionScoring) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionScoring.ProtoReflect.Descriptor instead.
+func (*SessionScoring) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SessionScoring) GetStartPoints() int32 {
+	if x != nil {
+		return x.StartPoints
+	}
+	return 0
+}
+
+func (x *SessionScoring) GetReturnPoints() int32 {
+	if x != nil {
+		return x.ReturnPoints
+	}
+	return 0
+}
+
+func (x *SessionScoring) GetUma() []float64 {
+	if x != nil {
+		return x.Uma
+	}
+	return nil
+}
+
+type SessionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerName    string  `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Seat          int32   `protobuf:"varint,2,opt,name=seat,proto3" json:"seat,omitempty"`
+	Robot         bool    `protobuf:"varint,3,opt,name=robot,proto3" json:"robot,omitempty"`
+	Score         int32   `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	Placement     int32   `protobuf:"varint,5,opt,name=placement,proto3" json:"placement,omitempty"`
+	AdjustedScore float64 `protobuf:"fixed64,6,opt,name=adjustedScore,proto3" json:"adjustedScore,omitempty"` // in thousands of points after return points, uma and oka
+}
+
+func (x *SessionResult) Reset() {
+	*x = SessionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionResult) ProtoMessage() {}
+
+func (x *SessionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionResult.ProtoReflect.Descriptor instead.
+func (*SessionResult) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SessionResult) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *SessionResult) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *SessionResult) GetRobot() bool {
+	if x != nil {
+		return x.Robot
+	}
+	return false
+}
+
+func (x *SessionResult) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SessionResult) GetPlacement() int32 {
+	if x != nil {
+		return x.Placement
+	}
+	return 0
+}
+
+func (x *SessionResult) GetAdjustedScore() float64 {
+	if x != nil {
+		return x.AdjustedScore
+	}
+	return 0
+}
+
+type SessionMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MatchID    string           `protobuf:"bytes,1,opt,name=matchID,proto3" json:"matchID,omitempty"`
+	FinishedAt int64            `protobuf:"varint,2,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+	Results    []*SessionResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SessionMatch) Reset() {
+	*x = SessionMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionMatch) ProtoMessage() {}
+
+func (x *SessionMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionMatch.ProtoReflect.Descriptor instead.
+func (*SessionMatch) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SessionMatch) GetMatchID() string {
+	if x != nil {
+		return x.MatchID
+	}
+	return ""
+}
+
+func (x *SessionMatch) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *SessionMatch) GetResults() []*SessionResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SessionStanding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerName    string  `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Robot         bool    `protobuf:"varint,2,opt,name=robot,proto3" json:"robot,omitempty"`
+	MatchCount    int32   `protobuf:"varint,3,opt,name=matchCount,proto3" json:"matchCount,omitempty"`
+	TotalScore    int64   `protobuf:"varint,4,opt,name=totalScore,proto3" json:"totalScore,omitempty"`
+	AdjustedScore float64 `protobuf:"fixed64,5,opt,name=adjustedScore,proto3" json:"adjustedScore,omitempty"`
+	Placements    []int32 `protobuf:"varint,6,rep,packed,name=placements,proto3" json:"placements,omitempty"` // count of 1st to 4th places
+}
+
+func (x *SessionStanding) Reset() {
+	*x = SessionStanding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionStanding) ProtoMessage() {}
+
+func (x *SessionStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionStanding.ProtoReflect.Descriptor instead.
+func (*SessionStanding) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SessionStanding) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *SessionStanding) GetRobot() bool {
+	if x != nil {
+		return x.Robot
+	}
+	return false
+}
+
+func (x *SessionStanding) GetMatchCount() int32 {
+	if x != nil {
+		return x.MatchCount
+	}
+	return 0
+}
+
+func (x *SessionStanding) GetTotalScore() int64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *SessionStanding) GetAdjustedScore() float64 {
+	if x != nil {
+		return x.AdjustedScore
+	}
+	return 0
+}
+
+func (x *SessionStanding) GetPlacements() []int32 {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+type SessionSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomID    string             `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	RoomName  string             `protobuf:"bytes,2,opt,name=roomName,proto3" json:"roomName,omitempty"`
+	StartedAt int64              `protobuf:"varint,3,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	Scoring   *SessionScoring    `protobuf:"bytes,4,opt,name=scoring,proto3" json:"scoring,omitempty"`
+	Standings []*SessionStanding `protobuf:"bytes,5,rep,name=standings,proto3" json:"standings,omitempty"` // best adjusted score first
+	Matches   []*SessionMatch    `protobuf:"bytes,6,rep,name=matches,proto3" json:"matches,omitempty"`
+}
+
+func (x *SessionSummary) Reset() {
+	*x = SessionSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionSummary) ProtoMessage() {}
+
+func (x *SessionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionSummary.ProtoReflect.Descriptor instead.
+func (*SessionSummary) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SessionSummary) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetScoring() *SessionScoring {
+	if x != nil {
+		return x.Scoring
+	}
+	return nil
+}
+
+func (x *SessionSummary) GetStandings() []*SessionStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *SessionSummary) GetMatches() []*SessionMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type GetSessionSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomID string       `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"` // the room of the caller if empty
+	Export ExportFormat `protobuf:"varint,2,opt,name=export,proto3,enum=mahjong.ExportFormat" json:"export,omitempty"`
+}
+
+func (x *GetSessionSummaryRequest) Reset() {
+	*x = GetSessionSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSessionSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionSummaryRequest) ProtoMessage() {}
+
+func (x *GetSessionSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetSessionSummaryRequest) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *GetSessionSummaryRequest) GetExport() ExportFormat {
+	if x != nil {
+		return x.Export
+	}
+	return ExportFormat_NoExport
+}
+
+type GetSessionSummaryReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string          `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Summary *SessionSummary `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Export  string          `protobuf:"bytes,3,opt,name=export,proto3" json:"export,omitempty"`
+}
+
+func (x *GetSessionSummaryReply) Reset() {
+	*x = GetSessionSummaryReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSessionSummaryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionSummaryReply) ProtoMessage() {}
+
+func (x *GetSessionSummaryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionSummaryReply.ProtoReflect.Descriptor instead.
+func (*GetSessionSummaryReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetSessionSummaryReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetSessionSummaryReply) GetSummary() *SessionSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *GetSessionSummaryReply) GetExport() string {
+	if x != nil {
+		return x.Export
+	}
+	return ""
+}
+
+type TournamentTable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round   int32    `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Table   int32    `protobuf:"varint,2,opt,name=table,proto3" json:"table,omitempty"`
+	RoomID  string   `protobuf:"bytes,3,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	Players []string `protobuf:"bytes,4,rep,name=players,proto3" json:"players,omitempty"` // by seat before the seat draw, empty for a robot
+	Done    bool     `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+}
+
+func (x *TournamentTable) Reset() {
+	*x = TournamentTable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentTable) ProtoMessage() {}
+
+func (x *TournamentTable) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentTable.ProtoReflect.Descriptor instead.
+func (*TournamentTable) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *TournamentTable) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *TournamentTable) GetTable() int32 {
+	if x != nil {
+		return x.Table
+	}
+	return 0
+}
+
+func (x *TournamentTable) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *TournamentTable) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *TournamentTable) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+type TournamentStanding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank       int32   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	PlayerName string  `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Points     float64 `protobuf:"fixed64,3,opt,name=points,proto3" json:"points,omitempty"` // sum of uma-adjusted scores
+	TotalScore int64   `protobuf:"varint,4,opt,name=totalScore,proto3" json:"totalScore,omitempty"`
+	MatchCount int32   `protobuf:"varint,5,opt,name=matchCount,proto3" json:"matchCount,omitempty"`
+	Placements []int32 `protobuf:"varint,6,rep,packed,name=placements,proto3" json:"placements,omitempty"` // count of 1st to 4th places
+	Dropped    bool    `protobuf:"varint,7,opt,name=dropped,proto3" json:"dropped,omitempty"`
+}
+
+func (x *TournamentStanding) Reset() {
+	*x = TournamentStanding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentStanding) ProtoMessage() {}
+
+func (x *TournamentStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentStanding.ProtoReflect.Descriptor instead.
+func (*TournamentStanding) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *TournamentStanding) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *TournamentStanding) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetTotalScore() int64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetMatchCount() int32 {
+	if x != nil {
+		return x.MatchCount
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetPlacements() []int32 {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+func (x *TournamentStanding) GetDropped() bool {
+	if x != nil {
+		return x.Dropped
+	}
+	return false
+}
+
+type Tournament struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentID string                `protobuf:"bytes,1,opt,name=tournamentID,proto3" json:"tournamentID,omitempty"`
+	Name         string                `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status       TournamentStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=mahjong.TournamentStatus" json:"status,omitempty"`
+	Pairing      TournamentPairing     `protobuf:"varint,4,opt,name=pairing,proto3,enum=mahjong.TournamentPairing" json:"pairing,omitempty"`
+	Round        int32                 `protobuf:"varint,5,opt,name=round,proto3" json:"round,omitempty"` // 0 before the start
+	Rounds       int32                 `protobuf:"varint,6,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	PlayerCount  int32                 `protobuf:"varint,7,opt,name=playerCount,proto3" json:"playerCount,omitempty"`
+	RuleSet      string                `protobuf:"bytes,8,opt,name=ruleSet,proto3" json:"ruleSet,omitempty"`
+	Scoring      *SessionScoring       `protobuf:"bytes,9,opt,name=scoring,proto3" json:"scoring,omitempty"`
+	Standings    []*TournamentStanding `protobuf:"bytes,10,rep,name=standings,proto3" json:"standings,omitempty"` // ties broken by total score, 1st places, average placement and seed
+	Tables       []*TournamentTable    `protobuf:"bytes,11,rep,name=tables,proto3" json:"tables,omitempty"`       // the current round
+}
+
+func (x *Tournament) Reset() {
+	*x = Tournament{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tournament) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tournament) ProtoMessage() {}
+
+func (x *Tournament) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tournament.ProtoReflect.Descriptor instead.
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Tournament) GetTournamentID() string {
+	if x != nil {
+		return x.TournamentID
+	}
+	return ""
+}
+
+func (x *Tournament) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tournament) GetStatus() TournamentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TournamentStatus_TournamentRegistering
+}
+
+func (x *Tournament) GetPairing() TournamentPairing {
+	if x != nil {
+		return x.Pairing
+	}
+	return TournamentPairing_SwissPairing
+}
+
+func (x *Tournament) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Tournament) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *Tournament) GetPlayerCount() int32 {
+	if x != nil {
+		return x.PlayerCount
+	}
+	return 0
+}
+
+func (x *Tournament) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *Tournament) GetScoring() *SessionScoring {
+	if x != nil {
+		return x.Scoring
+	}
+	return nil
+}
+
+func (x *Tournament) GetStandings() []*TournamentStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *Tournament) GetTables() []*TournamentTable {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type CreateTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rounds     int32             `protobuf:"varint,2,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	Pairing    TournamentPairing `protobuf:"varint,3,opt,name=pairing,proto3,enum=mahjong.TournamentPairing" json:"pairing,omitempty"`
+	RuleSet    string            `protobuf:"bytes,4,opt,name=ruleSet,proto3" json:"ruleSet,omitempty"`
+	Scoring    *SessionScoring   `protobuf:"bytes,5,opt,name=scoring,proto3,oneof" json:"scoring,omitempty"`
+	RobotLevel string            `protobuf:"bytes,6,opt,name=robotLevel,proto3" json:"robotLevel,omitempty"` // robot filling empty seats, Simple if empty
+}
+
+func (x *CreateTournamentRequest) Reset() {
+	*x = CreateTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentRequest) ProtoMessage() {}
+
+func (x *CreateTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateTournamentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetPairing() TournamentPairing {
+	if x != nil {
+		return x.Pairing
+	}
+	return TournamentPairing_SwissPairing
+}
+
+func (x *CreateTournamentRequest) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetScoring() *SessionScoring {
+	if x != nil {
+		return x.Scoring
+	}
+	return nil
+}
+
+func (x *CreateTournamentRequest) GetRobotLevel() string {
+	if x != nil {
+		return x.RobotLevel
+	}
+	return ""
+}
+
+type TournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentID string `protobuf:"bytes,1,opt,name=tournamentID,proto3" json:"tournamentID,omitempty"`
+}
+
+func (x *TournamentRequest) Reset() {
+	*x = TournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentRequest) ProtoMessage() {}
+
+func (x *TournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentRequest.ProtoReflect.Descriptor instead.
+func (*TournamentRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *TournamentRequest) GetTournamentID() string {
+	if x != nil {
+		return x.TournamentID
+	}
+	return ""
+}
+
+type TournamentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message    string      `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Tournament *Tournament `protobuf:"bytes,2,opt,name=tournament,proto3" json:"tournament,omitempty"`
+}
+
+func (x *TournamentReply) Reset() {
+	*x = TournamentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentReply) ProtoMessage() {}
+
+func (x *TournamentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentReply.ProtoReflect.Descriptor instead.
+func (*TournamentReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *TournamentReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TournamentReply) GetTournament() *Tournament {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+type ListTournamentsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message     string        `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Tournaments []*Tournament `protobuf:"bytes,2,rep,name=tournaments,proto3" json:"tournaments,omitempty"` // without standings and tables
+}
+
+func (x *ListTournamentsReply) Reset() {
+	*x = ListTournamentsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTournamentsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTournamentsReply) ProtoMessage() {}
+
+func (x *ListTournamentsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTournamentsReply.ProtoReflect.Descriptor instead.
+func (*ListTournamentsReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListTournamentsReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListTournamentsReply) GetTournaments() []*Tournament {
+	if x != nil {
+		return x.Tournaments
+	}
+	return nil
+}
+
+type Announcement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	SentAt  int64  `protobuf:"varint,2,opt,name=sentAt,proto3" json:"sentAt,omitempty"`
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Announcement) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Announcement) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
+}
+
+type AdminClient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerName     string `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Session        string `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	RoomID         string `protobuf:"bytes,3,opt,name=roomID,proto3" json:"roomID,omitempty"` // empty if not in a room
+	Seat           int32  `protobuf:"varint,4,opt,name=seat,proto3" json:"seat,omitempty"`
+	Ready          bool   `protobuf:"varint,5,opt,name=ready,proto3" json:"ready,omitempty"`
+	Rank           string `protobuf:"bytes,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	LastSeen       int64  `protobuf:"varint,7,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+	HasReadyStream bool   `protobuf:"varint,8,opt,name=hasReadyStream,proto3" json:"hasReadyStream,omitempty"`
+	HasStartStream bool   `protobuf:"varint,9,opt,name=hasStartStream,proto3" json:"hasStartStream,omitempty"`
+	InQueue        bool   `protobuf:"varint,10,opt,name=inQueue,proto3" json:"inQueue,omitempty"`
+}
+
+func (x *AdminClient) Reset() {
+	*x = AdminClient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminClient) ProtoMessage() {}
+
+func (x *AdminClient) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminClient.ProtoReflect.Descriptor instead.
+func (*AdminClient) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *AdminClient) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *AdminClient) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *AdminClient) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *AdminClient) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *AdminClient) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *AdminClient) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *AdminClient) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *AdminClient) GetHasReadyStream() bool {
+	if x != nil {
+		return x.HasReadyStream
+	}
+	return false
+}
+
+func (x *AdminClient) GetHasStartStream() bool {
+	if x != nil {
+		return x.HasStartStream
+	}
+	return false
+}
+
+func (x *AdminClient) GetInQueue() bool {
+	if x != nil {
+		return x.InQueue
+	}
+	return false
+}
+
+type ListClientsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string         `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Clients []*AdminClient `protobuf:"bytes,2,rep,name=clients,proto3" json:"clients,omitempty"`
+}
+
+func (x *ListClientsReply) Reset() {
+	*x = ListClientsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClientsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientsReply) ProtoMessage() {}
+
+func (x *ListClientsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientsReply.ProtoReflect.Descriptor instead.
+func (*ListClientsReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListClientsReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListClientsReply) GetClients() []*AdminClient {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+type AdminSeat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat       int32  `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Robot      bool   `protobuf:"varint,3,opt,name=robot,proto3" json:"robot,omitempty"`
+	Ready      bool   `protobuf:"varint,4,opt,name=ready,proto3" json:"ready,omitempty"`
+}
+
+func (x *AdminSeat) Reset() {
+	*x = AdminSeat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSeat) ProtoMessage() {}
+
+func (x *AdminSeat) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSeat.ProtoReflect.Descriptor instead.
+func (*AdminSeat) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *AdminSeat) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *AdminSeat) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *AdminSeat) GetRobot() bool {
+	if x != nil {
+		return x.Robot
+	}
+	return false
+}
+
+func (x *AdminSeat) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+type AdminRoom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Room         *Room        `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	InviteCode   string       `protobuf:"bytes,2,opt,name=inviteCode,proto3" json:"inviteCode,omitempty"`
+	Seats        []*AdminSeat `protobuf:"bytes,3,rep,name=seats,proto3" json:"seats,omitempty"`
+	Banned       []string     `protobuf:"bytes,4,rep,name=banned,proto3" json:"banned,omitempty"`
+	MatchCount   int32        `protobuf:"varint,5,opt,name=matchCount,proto3" json:"matchCount,omitempty"`    // matches of the session
+	TournamentID string       `protobuf:"bytes,6,opt,name=tournamentID,proto3" json:"tournamentID,omitempty"` // empty if not a tournament table
+}
+
+func (x *AdminRoom) Reset() {
+	*x = AdminRoom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRoom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRoom) ProtoMessage() {}
+
+func (x *AdminRoom) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRoom.ProtoReflect.Descriptor instead.
+func (*AdminRoom) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *AdminRoom) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *AdminRoom) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *AdminRoom) GetSeats() []*AdminSeat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *AdminRoom) GetBanned() []string {
+	if x != nil {
+		return x.Banned
+	}
+	return nil
+}
+
+func (x *AdminRoom) GetMatchCount() int32 {
+	if x != nil {
+		return x.MatchCount
+	}
+	return 0
+}
+
+func (x *AdminRoom) GetTournamentID() string {
+	if x != nil {
+		return x.TournamentID
+	}
+	return ""
+}
+
+type ListRoomsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string       `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Rooms   []*AdminRoom `protobuf:"bytes,2,rep,name=rooms,proto3" json:"rooms,omitempty"`
+}
+
+func (x *ListRoomsReply) Reset() {
+	*x = ListRoomsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRoomsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsReply) ProtoMessage() {}
+
+func (x *ListRoomsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsReply.ProtoReflect.Descriptor instead.
+func (*ListRoomsReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListRoomsReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListRoomsReply) GetRooms() []*AdminRoom {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+type AdminKickRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerName string `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Ban        bool   `protobuf:"varint,3,opt,name=ban,proto3" json:"ban,omitempty"`
+}
+
+func (x *AdminKickRequest) Reset() {
+	*x = AdminKickRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminKickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminKickRequest) ProtoMessage() {}
+
+func (x *AdminKickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminKickRequest.ProtoReflect.Descriptor instead.
+func (*AdminKickRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AdminKickRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *AdminKickRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminKickRequest) GetBan() bool {
+	if x != nil {
+		return x.Ban
+	}
+	return false
+}
+
+type AdminPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerName string `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+}
+
+func (x *AdminPlayerRequest) Reset() {
+	*x = AdminPlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminPlayerRequest) ProtoMessage() {}
+
+func (x *AdminPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminPlayerRequest.ProtoReflect.Descriptor instead.
+func (*AdminPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *AdminPlayerRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+type AdminRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomID string `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminRoomRequest) Reset() {
+	*x = AdminRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRoomRequest) ProtoMessage() {}
+
+func (x *AdminRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRoomRequest.ProtoReflect.Descriptor instead.
+func (*AdminRoomRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *AdminRoomRequest) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *AdminRoomRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AnnounceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *AnnounceRequest) Reset() {
+	*x = AnnounceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnounceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnounceRequest) ProtoMessage() {}
+
+func (x *AnnounceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnounceRequest.ProtoReflect.Descriptor instead.
+func (*AnnounceRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AnnounceRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ForceEndGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomID string  `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	Scores []int32 `protobuf:"varint,2,rep,packed,name=scores,proto3" json:"scores,omitempty"` // by seat, every seat keeps the start points if empty
+	Reason string  `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ForceEndGameRequest) Reset() {
+	*x = ForceEndGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceEndGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceEndGameRequest) ProtoMessage() {}
+
+func (x *ForceEndGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceEndGameRequest.ProtoReflect.Descriptor instead.
+func (*ForceEndGameRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ForceEndGameRequest) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *ForceEndGameRequest) GetScores() []int32 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *ForceEndGameRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *AdminReply) Reset() {
+	*x = AdminReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReply) ProtoMessage() {}
+
+func (x *AdminReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReply.ProtoReflect.Descriptor instead.
+func (*AdminReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *AdminReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SetLogLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level  string  `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`         // debug, info, warn, error, fatal or panic
+	RoomID *string `protobuf:"bytes,2,opt,name=roomID,proto3,oneof" json:"roomID,omitempty"` // only the lines about the room, the room is back to the server level once removed
+}
+
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelRequest) ProtoMessage() {}
+
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SetLogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *SetLogLevelRequest) GetRoomID() string {
+	if x != nil && x.RoomID != nil {
+		return *x.RoomID
+	}
+	return ""
+}
+
+type SetLogLevelReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message  string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Previous string `protobuf:"bytes,2,opt,name=previous,proto3" json:"previous,omitempty"`
+}
+
+func (x *SetLogLevelReply) Reset() {
+	*x = SetLogLevelReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelReply) ProtoMessage() {}
+
+func (x *SetLogLevelReply) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mahjong_v1_mahjong_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelReply.ProtoReflect.Descriptor instead.
+func (*SetLogLevelReply) Descriptor() ([]byte, []int) {
+	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SetLogLevelReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetLogLevelReply) GetPrevious() string {
+	if x != nil {
+		return x.Previous
+	}
+	return ""
+}
+
+var File_services_mahjong_v1_mahjong_proto protoreflect.FileDescriptor
+
+var file_services_mahjong_v1_mahjong_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x22, 0x07, 0x0a, 0x05,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x74, 0x0a, 0x0c, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x73, 0x0a, 0x0f, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x07, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x22, 0xac, 0x02, 0x0a, 0x0a, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07,
+	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x30, 0x0a,
+	0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x22,
+	0x4d, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x29,
+	0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4a, 0x0a, 0x0c, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x41, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x61, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x27, 0x0a, 0x0b, 0x4c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x2d, 0x0a,
+	0x08, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09,
+	0x68, 0x61, 0x6e, 0x64, 0x54, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x09, 0x68, 0x61, 0x6e, 0x64, 0x54, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x73, 0x22, 0xe6, 0x01, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x57,
+	0x69, 0x6e, 0x64, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x12,
+	0x22, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x54, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x54, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x73, 0x52, 0x69, 0x69, 0x63, 0x68, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x73, 0x52, 0x69, 0x69, 0x63, 0x68, 0x69, 0x22, 0xce, 0x02, 0x0a, 0x04, 0x52,
+	0x6f, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x73,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x68, 0x61, 0x73, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x73,
+	0x65, 0x61, 0x74, 0x44, 0x72, 0x61, 0x77, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x44, 0x72, 0x61, 0x77,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x73, 0x65, 0x61, 0x74, 0x44, 0x72, 0x61, 0x77, 0x12, 0x2b,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xd2, 0x03, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x74, 0x44,
+	0x72, 0x61, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x44, 0x72, 0x61, 0x77, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x08, 0x73, 0x65, 0x61, 0x74, 0x44, 0x72, 0x61, 0x77, 0x12, 0x27, 0x0a, 0x0c, 0x73, 0x65,
+	0x61, 0x74, 0x44, 0x72, 0x61, 0x77, 0x53, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x02, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x74, 0x44, 0x72, 0x61, 0x77, 0x53, 0x65, 0x65, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x44, 0x72, 0x61, 0x77, 0x4d, 0x6f,
+	0x64, 0x65, 0x48, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x72, 0x73, 0x12,
+	0x36, 0x0a, 0x07, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x04, 0x52, 0x07, 0x73, 0x63, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x75, 0x6c, 0x65,
+	0x53, 0x65, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x44, 0x72, 0x61, 0x77, 0x53, 0x65, 0x65,
+	0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x22, 0x6e, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a,
+	0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d,
+	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x22, 0x8b, 0x01, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a,
+	0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x88,
+	0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x80,
+	0x01, 0x0a, 0x0d, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x6f,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0x42, 0x0a, 0x12, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x72, 0x6f, 0x6f,
+	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x6f, 0x6f,
+	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x10, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x22, 0x41, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd6, 0x02, 0x0a, 0x0a,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x31, 0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52,
+	0x6f, 0x6f, 0x6d, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x3f, 0x0a, 0x12, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x48, 0x00, 0x52,
+	0x12, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x72, 0x6f,
+	0x6f, 0x6d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x6f,
+	0x6f, 0x6d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52,
+	0x0a, 0x72, 0x6f, 0x6f, 0x6d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x39, 0x0a, 0x12, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x72, 0x6f,
+	0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x22,
+	0x4a, 0x0a, 0x14, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x6f, 0x6f, 0x6d, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xbb, 0x05, 0x0a, 0x0c,
+	0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08,
+	0x67, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00,
+	0x52, 0x08, 0x67, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x32, 0x0a, 0x0b, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48,
+	0x00, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x36,
+	0x0a, 0x08, 0x61, 0x64, 0x64, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x6f,
+	0x62, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x61, 0x64,
+	0x64, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x09, 0x6c, 0x65,
+	0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x76,
+	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61,
+	0x74, 0x12, 0x39, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x53,
+	0x77, 0x61, 0x70, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x09, 0x73, 0x77, 0x61, 0x70, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x08,
+	0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x61,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65,
+	0x53, 0x65, 0x61, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x6b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x6b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x45, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x09,
+	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8f, 0x06, 0x0a, 0x0a, 0x52, 0x65,
+	0x61, 0x64, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x48, 0x00, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x34,
+	0x0a, 0x08, 0x67, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x61, 0x64, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x08, 0x67, 0x65, 0x74, 0x52,
+	0x65, 0x61, 0x64, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65,
+	0x61, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65,
+	0x61, 0x64, 0x79, 0x12, 0x34, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x41, 0x64, 0x64, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52,
+	0x08, 0x61, 0x64, 0x64, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68,
+	0x61, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00,
+	0x52, 0x09, 0x73, 0x77, 0x61, 0x70, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x6d,
+	0x6f, 0x76, 0x65, 0x53, 0x65, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x61, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x61,
+	0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x6b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48,
+	0x00, 0x52, 0x0a, 0x6b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x43, 0x0a,
+	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x65,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3b, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x9c, 0x01, 0x0a, 0x0c,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x04,
+	0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x70, 0x69,
+	0x6e, 0x67, 0x12, 0x29, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e,
+	0x65, 0x78, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x42,
+	0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf1, 0x03, 0x0a, 0x0a, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x70, 0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x72, 0x61,
+	0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x04, 0x64, 0x72, 0x61,
+	0x77, 0x12, 0x2f, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x44, 0x69, 0x73,
+	0x63, 0x61, 0x72, 0x64, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x07, 0x64, 0x69, 0x73, 0x63, 0x61,
+	0x72, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x4d,
+	0x73, 0x67, 0x48, 0x00, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x1e, 0x0a, 0x09, 0x67, 0x61,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x67, 0x61,
+	0x6d, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x28, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x34, 0x0a,
+	0x08, 0x73, 0x65, 0x61, 0x74, 0x44, 0x72, 0x61, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x44, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x08, 0x73, 0x65, 0x61, 0x74, 0x44,
+	0x72, 0x61, 0x77, 0x12, 0x3b, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x33, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2a,
+	0x0a, 0x10, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x22, 0x4f, 0x0a, 0x0f, 0x41, 0x64,
+	0x64, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x53, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x53, 0x65, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x6f, 0x62, 0x6f, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x35, 0x0a, 0x13, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65,
+	0x61, 0x74, 0x22, 0x3e, 0x0a, 0x10, 0x53, 0x77, 0x61, 0x70, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x41, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x41, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x65, 0x61, 0x74, 0x42, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x65, 0x61,
+	0x74, 0x42, 0x22, 0x25, 0x0a, 0x0f, 0x4d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x22, 0x45, 0x0a, 0x11, 0x4b, 0x69, 0x63,
+	0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x61, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x62, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x62, 0x61, 0x6e,
+	0x22, 0x2a, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x22, 0x28, 0x0a, 0x0e,
+	0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x70, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x27, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x57, 0x68, 0x6f, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52,
+	0x07, 0x66, 0x72, 0x6f, 0x6d, 0x57, 0x68, 0x6f, 0x22, 0xd1, 0x01, 0x0a, 0x08, 0x47, 0x61, 0x6d,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x57, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x72, 0x61,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x64, 0x6f, 0x72, 0x61, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x74, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x4e, 0x75, 0x6d, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x4e, 0x75, 0x6d,
+	0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x6e, 0x62, 0x61, 0x4e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x68, 0x6f, 0x6e, 0x62, 0x61, 0x4e, 0x75, 0x6d, 0x22, 0x4c, 0x0a, 0x07,
+	0x44, 0x72, 0x61, 0x77, 0x4d, 0x73, 0x67, 0x12, 0x1f, 0x0a, 0x03, 0x77, 0x68, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x57,
+	0x69, 0x6e, 0x64, 0x52, 0x03, 0x77, 0x68, 0x6f, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x69, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x69, 0x6c, 0x65, 0x22, 0x5f, 0x0a, 0x0a, 0x44, 0x69,
+	0x73, 0x63, 0x61, 0x72, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x1f, 0x0a, 0x03, 0x77, 0x68, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x57, 0x69, 0x6e, 0x64, 0x52, 0x03, 0x77, 0x68, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x73, 0x75, 0x6d, 0x6f, 0x47, 0x69, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x74, 0x73, 0x75, 0x6d, 0x6f, 0x47, 0x69, 0x72, 0x69, 0x22, 0xe3, 0x01, 0x0a, 0x07,
+	0x43, 0x61, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x12, 0x27, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x1f, 0x0a, 0x03, 0x77, 0x68, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52, 0x03, 0x77, 0x68,
+	0x6f, 0x12, 0x2c, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x57, 0x68, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x57, 0x69, 0x6e,
+	0x64, 0x48, 0x00, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x57, 0x68, 0x6f, 0x88, 0x01, 0x01, 0x12,
+	0x20, 0x0a, 0x0b, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x4f, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x4f, 0x6e, 0x48, 0x61, 0x6e,
+	0x64, 0x12, 0x23, 0x0a, 0x0a, 0x74, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x0a, 0x74, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x57,
+	0x68, 0x6f, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x22, 0x43, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x46, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4d,
+	0x0a, 0x0d, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x53, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x53, 0x65, 0x61, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x59, 0x0a,
+	0x0f, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x73, 0x65, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x64, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x61, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x27,
+	0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x45, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3c,
+	0x0a, 0x0e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x41, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x73, 0x65, 0x61, 0x74, 0x41, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x42, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x42, 0x22, 0x63, 0x0a, 0x0d,
+	0x4d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x53,
+	0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x6f, 0x53, 0x65, 0x61,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x5d, 0x0a, 0x0f, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6e,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64,
+	0x22, 0x50, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x53,
+	0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x53, 0x65, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x22, 0xfb, 0x01,
+	0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x2a, 0x0a, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x11, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x73, 0x65, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xef,
+	0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x6d,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x34, 0x0a, 0x07,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x66, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x66,
+	0x22, 0x2e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x60, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x22, 0x4b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0xa9, 0x03, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x61, 0x6e, 0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x68, 0x61, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x68, 0x61, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x77, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
+	0x77, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x61, 0x6c, 0x49,
+	0x6e, 0x52, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x61,
+	0x6c, 0x49, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x69, 0x69, 0x63, 0x68,
+	0x69, 0x52, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x72, 0x69, 0x69,
+	0x63, 0x68, 0x69, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x52,
+	0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x2a, 0x0a, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x57, 0x69, 0x6e, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x57, 0x69, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x5b, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x2b, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x22, 0xe6, 0x01, 0x0a, 0x10, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x5f, 0x0a, 0x10, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x6f, 0x62, 0x6f, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x6f,
+	0x62, 0x6f, 0x74, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74,
+	0x22, 0x74, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x77, 0x61,
+	0x69, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x77, 0x61, 0x69, 0x74, 0x65, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x65, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x62, 0x61, 0x6e, 0x64, 0x22, 0x4a, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x04, 0x72,
+	0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65,
+	0x61, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x36, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x42, 0x07,
+	0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x0a, 0x0f, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x74, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x53,
+	0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x53,
+	0x65, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x57, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x22, 0x91, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x74, 0x44, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x29, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x44,
+	0x72, 0x61, 0x77, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65,
+	0x64, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04,
+	0x64, 0x69, 0x63, 0x65, 0x22, 0x68, 0x0a, 0x0e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52, 0x03, 0x75, 0x6d, 0x61, 0x22, 0xb3,
+	0x01, 0x0a, 0x0d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x73, 0x65, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x22, 0x7a, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x22, 0xcd, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x64,
+	0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0xfe, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x07, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x22, 0x61, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0x7d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x12, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64,
+	0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0xb7, 0x03, 0x0a, 0x0a, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x34, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x70,
+	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74,
+	0x12, 0x31, 0x0a, 0x07, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x63, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x30,
+	0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x22, 0xf9, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x63, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x48, 0x00, 0x52, 0x07, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x37, 0x0a, 0x11,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x60, 0x0a, 0x0f, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x67, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0b, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x40, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x6e, 0x74, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74,
+	0x41, 0x74, 0x22, 0xa3, 0x02, 0x0a, 0x0b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
+	0x6f, 0x6d, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x26,
+	0x0a, 0x0e, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x68, 0x61, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x22, 0x5c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6b, 0x0a, 0x09, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53,
+	0x65, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x62, 0x6f, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x22, 0xd4, 0x01, 0x0a, 0x09, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04,
+	0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x54, 0x0a, 0x0e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73,
+	0x22, 0x5c, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4b, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03,
+	0x62, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x62, 0x61, 0x6e, 0x22, 0x34,
+	0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x42, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x2b, 0x0a, 0x0f, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x13, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x45, 0x6e,
+	0x64, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
+	0x6f, 0x6d, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x0a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x12,
+	0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d,
+	0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44,
+	0x22, 0x48, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x2a, 0x59, 0x0a, 0x0f, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x15, 0x0a,
+	0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x10, 0x02, 0x2a, 0x62, 0x0a, 0x0a, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x6f, 0x6f, 0x6d, 0x57, 0x61, 0x69, 0x74, 0x69,
+	0x6e, 0x67, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x6f, 0x6f, 0x6d, 0x50, 0x6c,
+	0x61, 0x79, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x6f, 0x6f, 0x6d, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x6f, 0x6f,
+	0x6d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x10, 0x04, 0x2a, 0x9e, 0x01, 0x0a, 0x0a, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x6b, 0x69, 0x70,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x10, 0x01, 0x12,
+	0x07, 0x0a, 0x03, 0x43, 0x68, 0x69, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x6f, 0x6e, 0x10,
+	0x03, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x61, 0x69, 0x4d, 0x69, 0x6e, 0x4b, 0x61, 0x6e, 0x10, 0x04,
+	0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x68, 0x6f, 0x75, 0x4d, 0x69, 0x6e, 0x4b, 0x61, 0x6e, 0x10, 0x05,
+	0x12, 0x09, 0x0a, 0x05, 0x41, 0x6e, 0x4b, 0x61, 0x6e, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x52,
+	0x69, 0x69, 0x63, 0x68, 0x69, 0x10, 0x07, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x6f, 0x6e, 0x10, 0x08,
+	0x12, 0x09, 0x0a, 0x05, 0x54, 0x73, 0x75, 0x6d, 0x6f, 0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x4b,
+	0x79, 0x75, 0x53, 0x68, 0x75, 0x4b, 0x79, 0x75, 0x48, 0x61, 0x69, 0x10, 0x0a, 0x12, 0x0b, 0x0a,
+	0x07, 0x43, 0x68, 0x61, 0x6e, 0x4b, 0x61, 0x6e, 0x10, 0x0b, 0x2a, 0x30, 0x0a, 0x04, 0x57, 0x69,
+	0x6e, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x61, 0x73, 0x74, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x53, 0x6f, 0x75, 0x74, 0x68, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x65, 0x73, 0x74, 0x10,
+	0x02, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x6f, 0x72, 0x74, 0x68, 0x10, 0x03, 0x2a, 0x4b, 0x0a, 0x10,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x0c, 0x0a, 0x08, 0x42, 0x79, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x42, 0x79, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x79, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x10, 0x02, 0x2a, 0x2e, 0x0a, 0x0a, 0x54, 0x69, 0x6d,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x6c, 0x6c, 0x54, 0x69,
+	0x6d, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x65, 0x65, 0x6b, 0x10, 0x01, 0x12, 0x09,
+	0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x10, 0x02, 0x2a, 0x48, 0x0a, 0x0c, 0x53, 0x65, 0x61,
+	0x74, 0x44, 0x72, 0x61, 0x77, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x6f, 0x44,
+	0x72, 0x61, 0x77, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x44,
+	0x72, 0x61, 0x77, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x57, 0x69, 0x6e, 0x64, 0x44, 0x72, 0x61,
+	0x77, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61,
+	0x77, 0x10, 0x03, 0x2a, 0x2f, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x6f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x10,
+	0x00, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53,
+	0x4f, 0x4e, 0x10, 0x02, 0x2a, 0x38, 0x0a, 0x11, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x77, 0x69,
+	0x73, 0x73, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53,
+	0x65, 0x65, 0x64, 0x65, 0x64, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x2a, 0x72,
+	0x0a, 0x10, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x10, 0x03, 0x32, 0x88, 0x0c, 0x0a, 0x07, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x12, 0x28,
+	0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c,
+	0x6f, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x48, 0x65, 0x6c, 0x6c,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x3e, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x35, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x0e,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x08,
+	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4a, 0x6f, 0x69,
+	0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0b,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1b, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x64,
+	0x79, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28,
+	0x01, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x15, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x38,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x50, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x3f, 0x0a, 0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x19,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x38, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x12, 0x0e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x21, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x0e, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x49, 0x0a, 0x0f, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x32, 0xc3, 0x06,
+	0x0a, 0x0c, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x3a,
+	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x0e, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x0e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0a, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x19, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4b, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x41, 0x0a, 0x0b, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x09, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x6f,
+	0x6f, 0x6d, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x12, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x43, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x47, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x46, 0x6f, 0x72, 0x63,
+	0x65, 0x45, 0x6e, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x74,
+	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x50, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x49, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0f,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x1a, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x42, 0x15, 0x5a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_services_mahjong_v1_mahjong_proto_rawDescOnce sync.Once
+	file_services_mahjong_v1_mahjong_proto_rawDescData = file_services_mahjong_v1_mahjong_proto_rawDesc
+)
+
+func file_services_mahjong_v1_mahjong_proto_rawDescGZIP() []byte {
+	file_services_mahjong_v1_mahjong_proto_rawDescOnce.Do(func() {
+		file_services_mahjong_v1_mahjong_proto_rawDescData = protoimpl.X.CompressGZIP(file_services_mahjong_v1_mahjong_proto_rawDescData)
+	})
+	return file_services_mahjong_v1_mahjong_proto_rawDescData
+}
+
+var file_services_mahjong_v1_mahjong_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_services_mahjong_v1_mahjong_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
+var file_services_mahjong_v1_mahjong_proto_goTypes = []interface{}{
+	(ProtocolSupport)(0),             // 0: mahjong.ProtocolSupport
+	(RoomStatus)(0),                  // 1: mahjong.RoomStatus
+	(ActionType)(0),                  // 2: mahjong.ActionType
+	(Wind)(0),                        // 3: mahjong.Wind
+	(LeaderboardOrder)(0),            // 4: mahjong.LeaderboardOrder
+	(TimeWindow)(0),                  // 5: mahjong.TimeWindow
+	(SeatDrawMode)(0),                // 6: mahjong.SeatDrawMode
+	(ExportFormat)(0),                // 7: mahjong.ExportFormat
+	(TournamentPairing)(0),           // 8: mahjong.TournamentPairing
+	(TournamentStatus)(0),            // 9: mahjong.TournamentStatus
+	(*Empty)(nil),                    // 10: mahjong.Empty
+	(*HelloRequest)(nil),             // 11: mahjong.HelloRequest
+	(*ProtocolRelease)(nil),          // 12: mahjong.ProtocolRelease
+	(*HelloReply)(nil),               // 13: mahjong.HelloReply
+	(*RegisterRequest)(nil),          // 14: mahjong.RegisterRequest
+	(*RegisterReply)(nil),            // 15: mahjong.RegisterReply
+	(*LoginRequest)(nil),             // 16: mahjong.LoginRequest
+	(*LoginReply)(nil),               // 17: mahjong.LoginReply
+	(*RefreshTokenReply)(nil),        // 18: mahjong.RefreshTokenReply
+	(*LogoutReply)(nil),              // 19: mahjong.LogoutReply
+	(*ReconnectInfo)(nil),            // 20: mahjong.ReconnectInfo
+	(*PlayerInfo)(nil),               // 21: mahjong.PlayerInfo
+	(*Room)(nil),                     // 22: mahjong.Room
+	(*CreateRoomRequest)(nil),        // 23: mahjong.CreateRoomRequest
+	(*CreateRoomReply)(nil),          // 24: mahjong.CreateRoomReply
+	(*JoinRoomRequest)(nil),          // 25: mahjong.JoinRoomRequest
+	(*JoinRoomReply)(nil),            // 26: mahjong.JoinRoomReply
+	(*RefreshRoomRequest)(nil),       // 27: mahjong.RefreshRoomRequest
+	(*RefreshRoomReply)(nil),         // 28: mahjong.RefreshRoomReply
+	(*WatchLobbyRequest)(nil),        // 29: mahjong.WatchLobbyRequest
+	(*LobbyReply)(nil),               // 30: mahjong.LobbyReply
+	(*LobbySnapshotReply)(nil),       // 31: mahjong.LobbySnapshotReply
+	(*LobbyRoomClosedReply)(nil),     // 32: mahjong.LobbyRoomClosedReply
+	(*ReadyRequest)(nil),             // 33: mahjong.ReadyRequest
+	(*ReadyReply)(nil),               // 34: mahjong.ReadyReply
+	(*StartRequest)(nil),             // 35: mahjong.StartRequest
+	(*StartReply)(nil),               // 36: mahjong.StartReply
+	(*LeaveRoomRequest)(nil),         // 37: mahjong.LeaveRoomRequest
+	(*AddRobotRequest)(nil),          // 38: mahjong.AddRobotRequest
+	(*RemovePlayerRequest)(nil),      // 39: mahjong.RemovePlayerRequest
+	(*SwapSeatsRequest)(nil),         // 40: mahjong.SwapSeatsRequest
+	(*MoveSeatRequest)(nil),          // 41: mahjong.MoveSeatRequest
+	(*KickPlayerRequest)(nil),        // 42: mahjong.KickPlayerRequest
+	(*TransferOwnerRequest)(nil),     // 43: mahjong.TransferOwnerRequest
+	(*RematchRequest)(nil),           // 44: mahjong.RematchRequest
+	(*Action)(nil),                   // 45: mahjong.Action
+	(*GameInfo)(nil),                 // 46: mahjong.GameInfo
+	(*DrawMsg)(nil),                  // 47: mahjong.DrawMsg
+	(*DiscardMsg)(nil),               // 48: mahjong.DiscardMsg
+	(*CallMsg)(nil),                  // 49: mahjong.CallMsg
+	(*GetReadyReply)(nil),            // 50: mahjong.GetReadyReply
+	(*CancelReadyReply)(nil),         // 51: mahjong.CancelReadyReply
+	(*AddRobotReply)(nil),            // 52: mahjong.AddRobotReply
+	(*PlayerJoinReply)(nil),          // 53: mahjong.PlayerJoinReply
+	(*PlayerLeaveReply)(nil),         // 54: mahjong.PlayerLeaveReply
+	(*ChatRequest)(nil),              // 55: mahjong.ChatRequest
+	(*ChatReply)(nil),                // 56: mahjong.ChatReply
+	(*SwapSeatsReply)(nil),           // 57: mahjong.SwapSeatsReply
+	(*MoveSeatReply)(nil),            // 58: mahjong.MoveSeatReply
+	(*KickPlayerReply)(nil),          // 59: mahjong.KickPlayerReply
+	(*TransferOwnerReply)(nil),       // 60: mahjong.TransferOwnerReply
+	(*RematchReply)(nil),             // 61: mahjong.RematchReply
+	(*PlayerProfile)(nil),            // 62: mahjong.PlayerProfile
+	(*GetProfileReply)(nil),          // 63: mahjong.GetProfileReply
+	(*MatchPlayerResult)(nil),        // 64: mahjong.MatchPlayerResult
+	(*MatchRecord)(nil),              // 65: mahjong.MatchRecord
+	(*GetMatchHistoryRequest)(nil),   // 66: mahjong.GetMatchHistoryRequest
+	(*GetMatchHistoryReply)(nil),     // 67: mahjong.GetMatchHistoryReply
+	(*GetPlayerStatsRequest)(nil),    // 68: mahjong.GetPlayerStatsRequest
+	(*PlayerStats)(nil),              // 69: mahjong.PlayerStats
+	(*GetPlayerStatsReply)(nil),      // 70: mahjong.GetPlayerStatsReply
+	(*GetLeaderboardRequest)(nil),    // 71: mahjong.GetLeaderboardRequest
+	(*LeaderboardEntry)(nil),         // 72: mahjong.LeaderboardEntry
+	(*GetLeaderboardReply)(nil),      // 73: mahjong.GetLeaderboardReply
+	(*JoinQueueRequest)(nil),         // 74: mahjong.JoinQueueRequest
+	(*QueueStatusReply)(nil),         // 75: mahjong.QueueStatusReply
+	(*QueueMatchedReply)(nil),        // 76: mahjong.QueueMatchedReply
+	(*QueueReply)(nil),               // 77: mahjong.QueueReply
+	(*LeaveQueueReply)(nil),          // 78: mahjong.LeaveQueueReply
+	(*SeatAssignment)(nil),           // 79: mahjong.SeatAssignment
+	(*SeatDrawReply)(nil),            // 80: mahjong.SeatDrawReply
+	(*SessionScoring)(nil),           // 81: mahjong.SessionScoring
+	(*SessionResult)(nil),            // 82: mahjong.SessionResult
+	(*SessionMatch)(nil),             // 83: mahjong.SessionMatch
+	(*SessionStanding)(nil),          // 84: mahjong.SessionStanding
+	(*SessionSummary)(nil),           // 85: mahjong.SessionSummary
+	(*GetSessionSummaryRequest)(nil), // 86: mahjong.GetSessionSummaryRequest
+	(*GetSessionSummaryReply)(nil),   // 87: mahjong.GetSessionSummaryReply
+	(*TournamentTable)(nil),          // 88: mahjong.TournamentTable
+	(*TournamentStanding)(nil),       // 89: mahjong.TournamentStanding
+	(*Tournament)(nil),               // 90: mahjong.Tournament
+	(*CreateTournamentRequest)(nil),  // 91: mahjong.CreateTournamentRequest
+	(*TournamentRequest)(nil),        // 92: mahjong.TournamentRequest
+	(*TournamentReply)(nil),          // 93: mahjong.TournamentReply
+	(*ListTournamentsReply)(nil),     // 94: mahjong.ListTournamentsReply
+	(*Announcement)(nil),             // 95: mahjong.Announcement
+	(*AdminClient)(nil),              // 96: mahjong.AdminClient
+	(*ListClientsReply)(nil),         // 97: mahjong.ListClientsReply
+	(*AdminSeat)(nil),                // 98: mahjong.AdminSeat
+	(*AdminRoom)(nil),                // 99: mahjong.AdminRoom
+	(*ListRoomsReply)(nil),           // 100: mahjong.ListRoomsReply
+	(*AdminKickRequest)(nil),         // 101: mahjong.AdminKickRequest
+	(*AdminPlayerRequest)(nil),       // 102: mahjong.AdminPlayerRequest
+	(*AdminRoomRequest)(nil),         // 103: mahjong.AdminRoomRequest
+	(*AnnounceRequest)(nil),          // 104: mahjong.AnnounceRequest
+	(*ForceEndGameRequest)(nil),      // 105: mahjong.ForceEndGameRequest
+	(*AdminReply)(nil),               // 106: mahjong.AdminReply
+	(*SetLogLevelRequest)(nil),       // 107: mahjong.SetLogLevelRequest
+	(*SetLogLevelReply)(nil),         // 108: mahjong.SetLogLevelReply
+}
+var file_services_mahjong_v1_mahjong_proto_depIdxs = []int32{
+	0,   // 0: mahjong.ProtocolRelease.support:type_name -> mahjong.ProtocolSupport
+	0,   // 1: mahjong.HelloReply.support:type_name -> mahjong.ProtocolSupport
+	12,  // 2: mahjong.HelloReply.matrix:type_name -> mahjong.ProtocolRelease
+	20,  // 3: mahjong.LoginReply.reconnectInfo:type_name -> mahjong.ReconnectInfo
+	46,  // 4: mahjong.ReconnectInfo.gameInfo:type_name -> mahjong.GameInfo
+	21,  // 5: mahjong.ReconnectInfo.playerInfos:type_name -> mahjong.PlayerInfo
+	3,   // 6: mahjong.PlayerInfo.playerWind:type_name -> mahjong.Wind
+	45,  // 7: mahjong.PlayerInfo.actions:type_name -> mahjong.Action
+	6,   // 8: mahjong.Room.seatDraw:type_name -> mahjong.SeatDrawMode
+	1,   // 9: mahjong.Room.status:type_name -> mahjong.RoomStatus
+	6,   // 10: mahjong.CreateRoomRequest.seatDraw:type_name -> mahjong.SeatDrawMode
+	6,   // 11: mahjong.CreateRoomRequest.rematchSeating:type_name -> mahjong.SeatDrawMode
+	81,  // 12: mahjong.CreateRoomRequest.scoring:type_name -> mahjong.SessionScoring
+	22,  // 13: mahjong.CreateRoomReply.room:type_name -> mahjong.Room
+	22,  // 14: mahjong.JoinRoomReply.room:type_name -> mahjong.Room
+	22,  // 15: mahjong.RefreshRoomReply.rooms:type_name -> mahjong.Room
+	31,  // 16: mahjong.LobbyReply.snapshot:type_name -> mahjong.LobbySnapshotReply
+	22,  // 17: mahjong.LobbyReply.roomCreated:type_name -> mahjong.Room
+	22,  // 18: mahjong.LobbyReply.playerCountChanged:type_name -> mahjong.Room
+	22,  // 19: mahjong.LobbyReply.statusChanged:type_name -> mahjong.Room
+	32,  // 20: mahjong.LobbyReply.roomClosed:type_name -> mahjong.LobbyRoomClosedReply
+	22,  // 21: mahjong.LobbySnapshotReply.rooms:type_name -> mahjong.Room
+	10,  // 22: mahjong.ReadyRequest.getReady:type_name -> mahjong.Empty
+	10,  // 23: mahjong.ReadyRequest.cancelReady:type_name -> mahjong.Empty
+	38,  // 24: mahjong.ReadyRequest.addRobot:type_name -> mahjong.AddRobotRequest
+	39,  // 25: mahjong.ReadyRequest.removePlayer:type_name -> mahjong.RemovePlayerRequest
+	37,  // 26: mahjong.ReadyRequest.leaveRoom:type_name -> mahjong.LeaveRoomRequest
+	10,  // 27: mahjong.ReadyRequest.startGame:type_name -> mahjong.Empty
+	55,  // 28: mahjong.ReadyRequest.chat:type_name -> mahjong.ChatRequest
+	40,  // 29: mahjong.ReadyRequest.swapSeats:type_name -> mahjong.SwapSeatsRequest
+	41,  // 30: mahjong.ReadyRequest.moveSeat:type_name -> mahjong.MoveSeatRequest
+	42,  // 31: mahjong.ReadyRequest.kickPlayer:type_name -> mahjong.KickPlayerRequest
+	43,  // 32: mahjong.ReadyRequest.transferOwner:type_name -> mahjong.TransferOwnerRequest
+	44,  // 33: mahjong.ReadyRequest.rematch:type_name -> mahjong.RematchRequest
+	53,  // 34: mahjong.ReadyReply.playerJoin:type_name -> mahjong.PlayerJoinReply
+	50,  // 35: mahjong.ReadyReply.getReady:type_name -> mahjong.GetReadyReply
+	51,  // 36: mahjong.ReadyReply.cancelReady:type_name -> mahjong.CancelReadyReply
+	52,  // 37: mahjong.ReadyReply.addRobot:type_name -> mahjong.AddRobotReply
+	54,  // 38: mahjong.ReadyReply.playerLeave:type_name -> mahjong.PlayerLeaveReply
+	10,  // 39: mahjong.ReadyReply.startGame:type_name -> mahjong.Empty
+	56,  // 40: mahjong.ReadyReply.chat:type_name -> mahjong.ChatReply
+	57,  // 41: mahjong.ReadyReply.swapSeats:type_name -> mahjong.SwapSeatsReply
+	58,  // 42: mahjong.ReadyReply.moveSeat:type_name -> mahjong.MoveSeatReply
+	59,  // 43: mahjong.ReadyReply.kickPlayer:type_name -> mahjong.KickPlayerReply
+	60,  // 44: mahjong.ReadyReply.transferOwner:type_name -> mahjong.TransferOwnerReply
+	61,  // 45: mahjong.ReadyReply.rematch:type_name -> mahjong.RematchReply
+	95,  // 46: mahjong.ReadyReply.announcement:type_name -> mahjong.Announcement
+	45,  // 47: mahjong.StartRequest.action:type_name -> mahjong.Action
+	55,  // 48: mahjong.StartRequest.chat:type_name -> mahjong.ChatRequest
+	47,  // 49: mahjong.StartReply.draw:type_name -> mahjong.DrawMsg
+	48,  // 50: mahjong.StartReply.discard:type_name -> mahjong.DiscardMsg
+	49,  // 51: mahjong.StartReply.call:type_name -> mahjong.CallMsg
+	46,  // 52: mahjong.StartReply.gameInitInfo:type_name -> mahjong.GameInfo
+	56,  // 53: mahjong.StartReply.chat:type_name -> mahjong.ChatReply
+	80,  // 54: mahjong.StartReply.seatDraw:type_name -> mahjong.SeatDrawReply
+	95,  // 55: mahjong.StartReply.announcement:type_name -> mahjong.Announcement
+	45,  // 56: mahjong.StartReply.validActions:type_name -> mahjong.Action
+	2,   // 57: mahjong.Action.type:type_name -> mahjong.ActionType
+	3,   // 58: mahjong.Action.fromWho:type_name -> mahjong.Wind
+	3,   // 59: mahjong.GameInfo.wind:type_name -> mahjong.Wind
+	3,   // 60: mahjong.DrawMsg.who:type_name -> mahjong.Wind
+	3,   // 61: mahjong.DiscardMsg.who:type_name -> mahjong.Wind
+	2,   // 62: mahjong.CallMsg.type:type_name -> mahjong.ActionType
+	3,   // 63: mahjong.CallMsg.who:type_name -> mahjong.Wind
+	3,   // 64: mahjong.CallMsg.fromWho:type_name -> mahjong.Wind
+	62,  // 65: mahjong.GetProfileReply.profile:type_name -> mahjong.PlayerProfile
+	64,  // 66: mahjong.MatchRecord.players:type_name -> mahjong.MatchPlayerResult
+	65,  // 67: mahjong.GetMatchHistoryReply.matches:type_name -> mahjong.MatchRecord
+	69,  // 68: mahjong.GetPlayerStatsReply.stats:type_name -> mahjong.PlayerStats
+	4,   // 69: mahjong.GetLeaderboardRequest.orderBy:type_name -> mahjong.LeaderboardOrder
+	5,   // 70: mahjong.GetLeaderboardRequest.window:type_name -> mahjong.TimeWindow
+	72,  // 71: mahjong.GetLeaderboardReply.entries:type_name -> mahjong.LeaderboardEntry
+	22,  // 72: mahjong.QueueMatchedReply.room:type_name -> mahjong.Room
+	75,  // 73: mahjong.QueueReply.status:type_name -> mahjong.QueueStatusReply
+	76,  // 74: mahjong.QueueReply.matched:type_name -> mahjong.QueueMatchedReply
+	10,  // 75: mahjong.QueueReply.left:type_name -> mahjong.Empty
+	3,   // 76: mahjong.SeatAssignment.wind:type_name -> mahjong.Wind
+	6,   // 77: mahjong.SeatDrawReply.mode:type_name -> mahjong.SeatDrawMode
+	79,  // 78: mahjong.SeatDrawReply.seats:type_name -> mahjong.SeatAssignment
+	82,  // 79: mahjong.SessionMatch.results:type_name -> mahjong.SessionResult
+	81,  // 80: mahjong.SessionSummary.scoring:type_name -> mahjong.SessionScoring
+	84,  // 81: mahjong.SessionSummary.standings:type_name -> mahjong.SessionStanding
+	83,  // 82: mahjong.SessionSummary.matches:type_name -> mahjong.SessionMatch
+	7,   // 83: mahjong.GetSessionSummaryRequest.export:type_name -> mahjong.ExportFormat
+	85,  // 84: mahjong.GetSessionSummaryReply.summary:type_name -> mahjong.SessionSummary
+	9,   // 85: mahjong.Tournament.status:type_name -> mahjong.TournamentStatus
+	8,   // 86: mahjong.Tournament.pairing:type_name -> mahjong.TournamentPairing
+	81,  // 87: mahjong.Tournament.scoring:type_name -> mahjong.SessionScoring
+	89,  // 88: mahjong.Tournament.standings:type_name -> mahjong.TournamentStanding
+	88,  // 89: mahjong.Tournament.tables:type_name -> mahjong.TournamentTable
+	8,   // 90: mahjong.CreateTournamentRequest.pairing:type_name -> mahjong.TournamentPairing
+	81,  // 91: mahjong.CreateTournamentRequest.scoring:type_name -> mahjong.SessionScoring
+	90,  // 92: mahjong.TournamentReply.tournament:type_name -> mahjong.Tournament
+	90,  // 93: mahjong.ListTournamentsReply.tournaments:type_name -> mahjong.Tournament
+	96,  // 94: mahjong.ListClientsReply.clients:type_name -> mahjong.AdminClient
+	22,  // 95: mahjong.AdminRoom.room:type_name -> mahjong.Room
+	98,  // 96: mahjong.AdminRoom.seats:type_name -> mahjong.AdminSeat
+	99,  // 97: mahjong.ListRoomsReply.rooms:type_name -> mahjong.AdminRoom
+	10,  // 98: mahjong.Mahjong.Ping:input_type -> mahjong.Empty
+	11,  // 99: mahjong.Mahjong.Hello:input_type -> mahjong.HelloRequest
+	14,  // 100: mahjong.Mahjong.Register:input_type -> mahjong.RegisterRequest
+	16,  // 101: mahjong.Mahjong.Login:input_type -> mahjong.LoginRequest
+	10,  // 102: mahjong.Mahjong.RefreshToken:input_type -> mahjong.Empty
+	10,  // 103: mahjong.Mahjong.Logout:input_type -> mahjong.Empty
+	23,  // 104: mahjong.Mahjong.CreateRoom:input_type -> mahjong.CreateRoomRequest
+	25,  // 105: mahjong.Mahjong.JoinRoom:input_type -> mahjong.JoinRoomRequest
+	27,  // 106: mahjong.Mahjong.RefreshRoom:input_type -> mahjong.RefreshRoomRequest
+	29,  // 107: mahjong.Mahjong.WatchLobby:input_type -> mahjong.WatchLobbyRequest
+	33,  // 108: mahjong.Mahjong.Ready:input_type -> mahjong.ReadyRequest
+	35,  // 109: mahjong.Mahjong.Start:input_type -> mahjong.StartRequest
+	10,  // 110: mahjong.Mahjong.GetProfile:input_type -> mahjong.Empty
+	66,  // 111: mahjong.Mahjong.GetMatchHistory:input_type -> mahjong.GetMatchHistoryRequest
+	68,  // 112: mahjong.Mahjong.GetPlayerStats:input_type -> mahjong.GetPlayerStatsRequest
+	71,  // 113: mahjong.Mahjong.GetLeaderboard:input_type -> mahjong.GetLeaderboardRequest
+	74,  // 114: mahjong.Mahjong.JoinQueue:input_type -> mahjong.JoinQueueRequest
+	10,  // 115: mahjong.Mahjong.LeaveQueue:input_type -> mahjong.Empty
+	86,  // 116: mahjong.Mahjong.GetSessionSummary:input_type -> mahjong.GetSessionSummaryRequest
+	10,  // 117: mahjong.Mahjong.ListTournaments:input_type -> mahjong.Empty
+	92,  // 118: mahjong.Mahjong.GetTournament:input_type -> mahjong.TournamentRequest
+	92,  // 119: mahjong.Mahjong.RegisterTournament:input_type -> mahjong.TournamentRequest
+	92,  // 120: mahjong.Mahjong.LeaveTournament:input_type -> mahjong.TournamentRequest
+	10,  // 121: mahjong.MahjongAdmin.ListClients:input_type -> mahjong.Empty
+	10,  // 122: mahjong.MahjongAdmin.ListRooms:input_type -> mahjong.Empty
+	101, // 123: mahjong.MahjongAdmin.KickPlayer:input_type -> mahjong.AdminKickRequest
+	102, // 124: mahjong.MahjongAdmin.UnbanPlayer:input_type -> mahjong.AdminPlayerRequest
+	103, // 125: mahjong.MahjongAdmin.CloseRoom:input_type -> mahjong.AdminRoomRequest
+	104, // 126: mahjong.MahjongAdmin.Announce:input_type -> mahjong.AnnounceRequest
+	105, // 127: mahjong.MahjongAdmin.ForceEndGame:input_type -> mahjong.ForceEndGameRequest
+	107, // 128: mahjong.MahjongAdmin.SetLogLevel:input_type -> mahjong.SetLogLevelRequest
+	91,  // 129: mahjong.MahjongAdmin.CreateTournament:input_type -> mahjong.CreateTournamentRequest
+	92,  // 130: mahjong.MahjongAdmin.StartTournament:input_type -> mahjong.TournamentRequest
+	92,  // 131: mahjong.MahjongAdmin.PauseTournament:input_type -> mahjong.TournamentRequest
+	92,  // 132: mahjong.MahjongAdmin.RepairTournament:input_type -> mahjong.TournamentRequest
+	10,  // 133: mahjong.Mahjong.Ping:output_type -> mahjong.Empty
+	13,  // 134: mahjong.Mahjong.Hello:output_type -> mahjong.HelloReply
+	15,  // 135: mahjong.Mahjong.Register:output_type -> mahjong.RegisterReply
+	17,  // 136: mahjong.Mahjong.Login:output_type -> mahjong.LoginReply
+	18,  // 137: mahjong.Mahjong.RefreshToken:output_type -> mahjong.RefreshTokenReply
+	19,  // 138: mahjong.Mahjong.Logout:output_type -> mahjong.LogoutReply
+	24,  // 139: mahjong.Mahjong.CreateRoom:output_type -> mahjong.CreateRoomReply
+	26,  // 140: mahjong.Mahjong.JoinRoom:output_type -> mahjong.JoinRoomReply
+	28,  // 141: mahjong.Mahjong.RefreshRoom:output_type -> mahjong.RefreshRoomReply
+	30,  // 142: mahjong.Mahjong.WatchLobby:output_type -> mahjong.LobbyReply
+	34,  // 143: mahjong.Mahjong.Ready:output_type -> mahjong.ReadyReply
+	36,  // 144: mahjong.Mahjong.Start:output_type -> mahjong.StartReply
+	63,  // 145: mahjong.Mahjong.GetProfile:output_type -> mahjong.GetProfileReply
+	67,  // 146: mahjong.Mahjong.GetMatchHistory:output_type -> mahjong.GetMatchHistoryReply
+	70,  // 147: mahjong.Mahjong.GetPlayerStats:output_type -> mahjong.GetPlayerStatsReply
+	73,  // 148: mahjong.Mahjong.GetLeaderboard:output_type -> mahjong.GetLeaderboardReply
+	77,  // 149: mahjong.Mahjong.JoinQueue:output_type -> mahjong.QueueReply
+	78,  // 150: mahjong.Mahjong.LeaveQueue:output_type -> mahjong.LeaveQueueReply
+	87,  // 151: mahjong.Mahjong.GetSessionSummary:output_type -> mahjong.GetSessionSummaryReply
+	94,  // 152: mahjong.Mahjong.ListTournaments:output_type -> mahjong.ListTournamentsReply
+	93,  // 153: mahjong.Mahjong.GetTournament:output_type -> mahjong.TournamentReply
+	93,  // 154: mahjong.Mahjong.RegisterTournament:output_type -> mahjong.TournamentReply
+	93,  // 155: mahjong.Mahjong.LeaveTournament:output_type -> mahjong.TournamentReply
+	97,  // 156: mahjong.MahjongAdmin.ListClients:output_type -> mahjong.ListClientsReply
+	100, // 157: mahjong.MahjongAdmin.ListRooms:output_type -> mahjong.ListRoomsReply
+	106, // 158: mahjong.MahjongAdmin.KickPlayer:output_type -> mahjong.AdminReply
+	106, // 159: mahjong.MahjongAdmin.UnbanPlayer:output_type -> mahjong.AdminReply
+	106, // 160: mahjong.MahjongAdmin.CloseRoom:output_type -> mahjong.AdminReply
+	106, // 161: mahjong.MahjongAdmin.Announce:output_type -> mahjong.AdminReply
+	106, // 162: mahjong.MahjongAdmin.ForceEndGame:output_type -> mahjong.AdminReply
+	108, // 163: mahjong.MahjongAdmin.SetLogLevel:output_type -> mahjong.SetLogLevelReply
+	93,  // 164: mahjong.MahjongAdmin.CreateTournament:output_type -> mahjong.TournamentReply
+	93,  // 165: mahjong.MahjongAdmin.StartTournament:output_type -> mahjong.TournamentReply
+	93,  // 166: mahjong.MahjongAdmin.PauseTournament:output_type -> mahjong.TournamentReply
+	93,  // 167: mahjong.MahjongAdmin.RepairTournament:output_type -> mahjong.TournamentReply
+	133, // [133:168] is the sub-list for method output_type
+	98,  // [98:133] is the sub-list for method input_type
+	98,  // [98:98] is the sub-list for extension type_name
+	98,  // [98:98] is the sub-list for extension extendee
+	0,   // [0:98] is the sub-list for field type_name
+}
+
+func init() { file_services_mahjong_v1_mahjong_proto_init() }
+func file_services_mahjong_v1_mahjong_proto_init() {
+	if File_services_mahjong_v1_mahjong_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HelloRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolRelease); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HelloReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconnectInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Room); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoomReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRoomReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshRoomReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchLobbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbySnapshotReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyRoomClosedReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadyReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRobotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePlayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapSeatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveSeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickPlayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferOwnerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RematchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Action); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscardMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReadyReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelReadyReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRobotReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerJoinReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerLeaveReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapSeatsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveSeatReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickPlayerReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferOwnerReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RematchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProfileReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchPlayerResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMatchHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMatchHistoryReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerStatsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaderboardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ChatReply.ProtoReflect.Descriptor instead.
-func (*ChatReply) Descriptor() ([]byte, []int) {
-	return file_services_mahjong_v1_mahjong_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *ChatReply) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *ChatReply) GetPlayerName() string {
-	if x != nil {
-		return x.PlayerName
-	}
-	return ""
-}
-
-var File_services_mahjong_v1_mahjong_proto protoreflect.FileDescriptor
-
-var file_services_mahjong_v1_mahjong_proto_rawDesc = []byte{
-	0x0a, 0x21, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
-	0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x22, 0x07, 0x0a, 0x05,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2e, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x41, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61,
-	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x27, 0x0a, 0x0b, 0x4c, 0x6f, 0x67,
-	0x6f, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x08,
-	0x67, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x68,
-	0x61, 0x6e, 0x64, 0x54, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09,
-	0x68, 0x61, 0x6e, 0x64, 0x54, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73,
-	0x22, 0xe6, 0x01, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x2d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x57, 0x69,
-	0x6e, 0x64, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x12, 0x22,
-	0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x54, 0x69, 0x6c, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x54, 0x69, 0x6c,
-	0x65, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x69, 0x73, 0x52, 0x69, 0x69, 0x63, 0x68, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x69, 0x73, 0x52, 0x69, 0x69, 0x63, 0x68, 0x69, 0x22, 0x7a, 0x0a, 0x04, 0x52, 0x6f, 0x6f,
-	0x6d, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f,
-	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f,
-	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f,
-	0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f,
-	0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4e, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x6f, 0x6f, 0x6d,
-	0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0x29, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f,
-	0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f,
-	0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49,
-	0x44, 0x22, 0x60, 0x0a, 0x0d, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x04,
-	0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68,
-	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73,
-	0x65, 0x61, 0x74, 0x22, 0x42, 0x0a, 0x12, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x6f,
-	0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x6f, 0x6f,
-	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x72,
-	0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72,
-	0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x10, 0x52, 0x65, 0x66, 0x72, 0x65,
-	0x73, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52,
-	0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x22, 0x8e, 0x03, 0x0a, 0x0c, 0x52,
-	0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x67,
-	0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52,
-	0x08, 0x67, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x32, 0x0a, 0x0b, 0x63, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00,
-	0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x36, 0x0a,
-	0x08, 0x61, 0x64, 0x64, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x62,
-	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x61, 0x64, 0x64,
-	0x52, 0x6f, 0x62, 0x6f, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61,
-	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x09, 0x6c, 0x65, 0x61,
-	0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d,
-	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x76, 0x65,
-	0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
-	0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x47, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x43, 0x68, 0x61,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74,
-	0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xaf, 0x03, 0x0a, 0x0a,
-	0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x6f,
-	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
-	0x6e, 0x67, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e,
-	0x12, 0x34, 0x0a, 0x08, 0x67, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x08, 0x67, 0x65,
-	0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61,
-	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64,
-	0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x34, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x52, 0x6f, 0x62, 0x6f,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
-	0x67, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48,
-	0x00, 0x52, 0x08, 0x61, 0x64, 0x64, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52,
-	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x63, 0x68,
-	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
-	0x6e, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x04,
-	0x63, 0x68, 0x61, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x9c, 0x01,
-	0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
-	0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04,
-	0x70, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x14, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x43, 0x68,
-	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61,
-	0x74, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xfe, 0x02, 0x0a,
-	0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x70, 0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x04, 0x64,
-	0x72, 0x61, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
-	0x6f, 0x6e, 0x67, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x04, 0x64,
-	0x72, 0x61, 0x77, 0x12, 0x2f, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x44,
-	0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x07, 0x64, 0x69, 0x73,
-	0x63, 0x61, 0x72, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x6c,
-	0x6c, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x1e, 0x0a, 0x09,
-	0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x37, 0x0a, 0x0c,
-	0x67, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x47, 0x61, 0x6d,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x69,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x43, 0x68,
-	0x61, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12,
-	0x33, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2a, 0x0a,
-	0x10, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x22, 0x4f, 0x0a, 0x0f, 0x41, 0x64, 0x64,
-	0x52, 0x6f, 0x62, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x72, 0x6f, 0x62, 0x6f, 0x74, 0x53, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x09, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x53, 0x65, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x6f,
-	0x62, 0x6f, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x72, 0x6f, 0x62, 0x6f, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x35, 0x0a, 0x13, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x61, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x61,
-	0x74, 0x22, 0x70, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
-	0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x05, 0x52, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x07, 0x66, 0x72,
-	0x6f, 0x6d, 0x57, 0x68, 0x6f, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6d, 0x61,
-	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d,
-	0x57, 0x68, 0x6f, 0x22, 0xd1, 0x01, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x21, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
-	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x77,
-	0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x72, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x05, 0x52, 0x04, 0x64, 0x6f, 0x72, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x77, 0x69, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x4e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x68,
-	0x6f, 0x6e, 0x62, 0x61, 0x4e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x68,
-	0x6f, 0x6e, 0x62, 0x61, 0x4e, 0x75, 0x6d, 0x22, 0x4c, 0x0a, 0x07, 0x44, 0x72, 0x61, 0x77, 0x4d,
-	0x73, 0x67, 0x12, 0x1f, 0x0a, 0x03, 0x77, 0x68, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52, 0x03,
-	0x77, 0x68, 0x6f, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05,
-	0x5f, 0x74, 0x69, 0x6c, 0x65, 0x22, 0x5f, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
-	0x4d, 0x73, 0x67, 0x12, 0x1f, 0x0a, 0x03, 0x77, 0x68, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52,
-	0x03, 0x77, 0x68, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x73, 0x75, 0x6d,
-	0x6f, 0x47, 0x69, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x73, 0x75,
-	0x6d, 0x6f, 0x47, 0x69, 0x72, 0x69, 0x22, 0xe3, 0x01, 0x0a, 0x07, 0x43, 0x61, 0x6c, 0x6c, 0x4d,
-	0x73, 0x67, 0x12, 0x27, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x03, 0x77,
-	0x68, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
-	0x6e, 0x67, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52, 0x03, 0x77, 0x68, 0x6f, 0x12, 0x2c, 0x0a, 0x07,
-	0x66, 0x72, 0x6f, 0x6d, 0x57, 0x68, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
-	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x07,
-	0x66, 0x72, 0x6f, 0x6d, 0x57, 0x68, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x69,
-	0x6c, 0x65, 0x73, 0x4f, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52,
-	0x0b, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x4f, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0a,
-	0x74, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x48, 0x01, 0x52, 0x0a, 0x74, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x88, 0x01,
-	0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x57, 0x68, 0x6f, 0x42, 0x0d, 0x0a,
-	0x0b, 0x5f, 0x74, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0x43, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x46, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x79,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4d, 0x0a, 0x0d, 0x41, 0x64, 0x64,
-	0x52, 0x6f, 0x62, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f,
-	0x62, 0x6f, 0x74, 0x53, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72,
-	0x6f, 0x62, 0x6f, 0x74, 0x53, 0x65, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x6f, 0x62, 0x6f,
-	0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f,
-	0x62, 0x6f, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x45, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x64, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x53, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x53, 0x65, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x27, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x45,
-	0x0a, 0x09, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x2a, 0x9e, 0x01, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x6b, 0x69, 0x70, 0x10, 0x00, 0x12, 0x0b,
-	0x0a, 0x07, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x43,
-	0x68, 0x69, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x6f, 0x6e, 0x10, 0x03, 0x12, 0x0d, 0x0a,
-	0x09, 0x44, 0x61, 0x69, 0x4d, 0x69, 0x6e, 0x4b, 0x61, 0x6e, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a,
-	0x53, 0x68, 0x6f, 0x75, 0x4d, 0x69, 0x6e, 0x4b, 0x61, 0x6e, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05,
-	0x41, 0x6e, 0x4b, 0x61, 0x6e, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x69, 0x69, 0x63, 0x68,
-	0x69, 0x10, 0x07, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x6f, 0x6e, 0x10, 0x08, 0x12, 0x09, 0x0a, 0x05,
-	0x54, 0x73, 0x75, 0x6d, 0x6f, 0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x4b, 0x79, 0x75, 0x53, 0x68,
-	0x75, 0x4b, 0x79, 0x75, 0x48, 0x61, 0x69, 0x10, 0x0a, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x68, 0x61,
-	0x6e, 0x4b, 0x61, 0x6e, 0x10, 0x0b, 0x2a, 0x30, 0x0a, 0x04, 0x57, 0x69, 0x6e, 0x64, 0x12, 0x08,
-	0x0a, 0x04, 0x45, 0x61, 0x73, 0x74, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x6f, 0x75, 0x74,
-	0x68, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x65, 0x73, 0x74, 0x10, 0x02, 0x12, 0x09, 0x0a,
-	0x05, 0x4e, 0x6f, 0x72, 0x74, 0x68, 0x10, 0x03, 0x32, 0xe1, 0x03, 0x0a, 0x07, 0x4d, 0x61, 0x68,
-	0x6a, 0x6f, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x2e, 0x6d,
-	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x6d,
-	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x35,
-	0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
-	0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
-	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12,
-	0x0e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x14, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a,
-	0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
-	0x6f, 0x6e, 0x67, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x4a, 0x6f,
-	0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a,
-	0x0b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1b, 0x2e, 0x6d,
-	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x6f,
-	0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
-	0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12,
-	0x15, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
-	0x2e, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x30,
-	0x01, 0x12, 0x39, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x68,
-	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x15, 0x5a, 0x13,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
-	0x2e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_services_mahjong_v1_mahjong_proto_rawDescOnce sync.Once
-	file_services_mahjong_v1_mahjong_proto_rawDescData = file_services_mahjong_v1_mahjong_proto_rawDesc
-)
-
-func file_services_mahjong_v1_mahjong_proto_rawDescGZIP() []byte {
-	file_services_mahjong_v1_mahjong_proto_rawDescOnce.Do(func() {
-		file_services_mahjong_v1_mahjong_proto_rawDescData = protoimpl.X.CompressGZIP(file_services_mahjong_v1_mahjong_proto_rawDescData)
-	})
-	return file_services_mahjong_v1_mahjong_proto_rawDescData
-}
-
-var file_services_mahjong_v1_mahjong_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_services_mahjong_v1_mahjong_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
-var file_services_mahjong_v1_mahjong_proto_goTypes = []interface{}{
-	(ActionType)(0),             // 0: mahjong.ActionType
-	(Wind)(0),                   // 1: mahjong.Wind
-	(*Empty)(nil),               // 2: mahjong.Empty
-	(*LoginRequest)(nil),        // 3: mahjong.LoginRequest
-	(*LoginReply)(nil),          // 4: mahjong.LoginReply
-	(*LogoutReply)(nil),         // 5: mahjong.LogoutReply
-	(*ReconnectInfo)(nil),       // 6: mahjong.ReconnectInfo
-	(*PlayerInfo)(nil),          // 7: mahjong.PlayerInfo
-	(*Room)(nil),                // 8: mahjong.Room
-	(*CreateRoomRequest)(nil),   // 9: mahjong.CreateRoomRequest
-	(*CreateRoomReply)(nil),     // 10: mahjong.CreateRoomReply
-	(*JoinRoomRequest)(nil),     // 11: mahjong.JoinRoomRequest
-	(*JoinRoomReply)(nil),       // 12: mahjong.JoinRoomReply
-	(*RefreshRoomRequest)(nil),  // 13: mahjong.RefreshRoomRequest
-	(*RefreshRoomReply)(nil),    // 14: mahjong.RefreshRoomReply
-	(*ReadyRequest)(nil),        // 15: mahjong.ReadyRequest
-	(*ReadyReply)(nil),          // 16: mahjong.ReadyReply
-	(*StartRequest)(nil),        // 17: mahjong.StartRequest
-	(*StartReply)(nil),          // 18: mahjong.StartReply
-	(*LeaveRoomRequest)(nil),    // 19: mahjong.LeaveRoomRequest
-	(*AddRobotRequest)(nil),     // 20: mahjong.AddRobotRequest
-	(*RemovePlayerRequest)(nil), // 21: mahjong.RemovePlayerRequest
-	(*Action)(nil),              // 22: mahjong.Action
-	(*GameInfo)(nil),            // 23: mahjong.GameInfo
-	(*DrawMsg)(nil),             // 24: mahjong.DrawMsg
-	(*DiscardMsg)(nil),          // 25: mahjong.DiscardMsg
-	(*CallMsg)(nil),             // 26: mahjong.CallMsg
-	(*GetReadyReply)(nil),       // 27: mahjong.GetReadyReply
-	(*CancelReadyReply)(nil),    // 28: mahjong.CancelReadyReply
-	(*AddRobotReply)(nil),       // 29: mahjong.AddRobotReply
-	(*PlayerJoinReply)(nil),     // 30: mahjong.PlayerJoinReply
-	(*PlayerLeaveReply)(nil),    // 31: mahjong.PlayerLeaveReply
-	(*ChatRequest)(nil),         // 32: mahjong.ChatRequest
-	(*ChatReply)(nil),           // 33: mahjong.ChatReply
-}
-var file_services_mahjong_v1_mahjong_proto_depIdxs = []int32{
-	6,  // 0: mahjong.LoginReply.reconnectInfo:type_name -> mahjong.ReconnectInfo
-	23, // 1: mahjong.ReconnectInfo.gameInfo:type_name -> mahjong.GameInfo
-	7,  // 2: mahjong.ReconnectInfo.playerInfos:type_name -> mahjong.PlayerInfo
-	1,  // 3: mahjong.PlayerInfo.playerWind:type_name -> mahjong.Wind
-	22, // 4: mahjong.PlayerInfo.actions:type_name -> mahjong.Action
-	8,  // 5: mahjong.CreateRoomReply.room:type_name -> mahjong.Room
-	8,  // 6: mahjong.JoinRoomReply.room:type_name -> mahjong.Room
-	8,  // 7: mahjong.RefreshRoomReply.rooms:type_name -> mahjong.Room
-	2,  // 8: mahjong.ReadyRequest.getReady:type_name -> mahjong.Empty
-	2,  // 9: mahjong.ReadyRequest.cancelReady:type_name -> mahjong.Empty
-	20, // 10: mahjong.ReadyRequest.addRobot:type_name -> mahjong.AddRobotRequest
-	21, // 11: mahjong.ReadyRequest.removePlayer:type_name -> mahjong.RemovePlayerRequest
-	19, // 12: mahjong.ReadyRequest.leaveRoom:type_name -> mahjong.LeaveRoomRequest
-	2,  // 13: mahjong.ReadyRequest.startGame:type_name -> mahjong.Empty
-	32, // 14: mahjong.ReadyRequest.chat:type_name -> mahjong.ChatRequest
-	30, // 15: mahjong.ReadyReply.playerJoin:type_name -> mahjong.PlayerJoinReply
-	27, // 16: mahjong.ReadyReply.getReady:type_name -> mahjong.GetReadyReply
-	28, // 17: mahjong.ReadyReply.cancelReady:type_name -> mahjong.CancelReadyReply
-	29, // 18: mahjong.ReadyReply.addRobot:type_name -> mahjong.AddRobotReply
-	31, // 19: mahjong.ReadyReply.playerLeave:type_name -> mahjong.PlayerLeaveReply
-	2,  // 20: mahjong.ReadyReply.startGame:type_name -> mahjong.Empty
-	33, // 21: mahjong.ReadyReply.chat:type_name -> mahjong.ChatReply
-	22, // 22: mahjong.StartRequest.action:type_name -> mahjong.Action
-	32, // 23: mahjong.StartRequest.chat:type_name -> mahjong.ChatRequest
-	24, // 24: mahjong.StartReply.draw:type_name -> mahjong.DrawMsg
-	25, // 25: mahjong.StartReply.discard:type_name -> mahjong.DiscardMsg
-	26, // 26: mahjong.StartReply.call:type_name -> mahjong.CallMsg
-	23, // 27: mahjong.StartReply.gameInitInfo:type_name -> mahjong.GameInfo
-	33, // 28: mahjong.StartReply.chat:type_name -> mahjong.ChatReply
-	22, // 29: mahjong.StartReply.validActions:type_name -> mahjong.Action
-	0,  // 30: mahjong.Action.type:type_name -> mahjong.ActionType
-	1,  // 31: mahjong.Action.fromWho:type_name -> mahjong.Wind
-	1,  // 32: mahjong.GameInfo.wind:type_name -> mahjong.Wind
-	1,  // 33: mahjong.DrawMsg.who:type_name -> mahjong.Wind
-	1,  // 34: mahjong.DiscardMsg.who:type_name -> mahjong.Wind
-	0,  // 35: mahjong.CallMsg.type:type_name -> mahjong.ActionType
-	1,  // 36: mahjong.CallMsg.who:type_name -> mahjong.Wind
-	1,  // 37: mahjong.CallMsg.fromWho:type_name -> mahjong.Wind
-	2,  // 38: mahjong.Mahjong.Ping:input_type -> mahjong.Empty
-	3,  // 39: mahjong.Mahjong.Login:input_type -> mahjong.LoginRequest
-	2,  // 40: mahjong.Mahjong.Logout:input_type -> mahjong.Empty
-	9,  // 41: mahjong.Mahjong.CreateRoom:input_type -> mahjong.CreateRoomRequest
-	11, // 42: mahjong.Mahjong.JoinRoom:input_type -> mahjong.JoinRoomRequest
-	13, // 43: mahjong.Mahjong.RefreshRoom:input_type -> mahjong.RefreshRoomRequest
-	15, // 44: mahjong.Mahjong.Ready:input_type -> mahjong.ReadyRequest
-	17, // 45: mahjong.Mahjong.Start:input_type -> mahjong.StartRequest
-	2,  // 46: mahjong.Mahjong.Ping:output_type -> mahjong.Empty
-	4,  // 47: mahjong.Mahjong.Login:output_type -> mahjong.LoginReply
-	5,  // 48: mahjong.Mahjong.Logout:output_type -> mahjong.LogoutReply
-	10, // 49: mahjong.Mahjong.CreateRoom:output_type -> mahjong.CreateRoomReply
-	12, // 50: mahjong.Mahjong.JoinRoom:output_type -> mahjong.JoinRoomReply
-	14, // 51: mahjong.Mahjong.RefreshRoom:output_type -> mahjong.RefreshRoomReply
-	16, // 52: mahjong.Mahjong.Ready:output_type -> mahjong.ReadyReply
-	18, // 53: mahjong.Mahjong.Start:output_type -> mahjong.StartReply
-	46, // [46:54] is the sub-list for method output_type
-	38, // [38:46] is the sub-list for method input_type
-	38, // [38:38] is the sub-list for extension type_name
-	38, // [38:38] is the sub-list for extension extendee
-	0,  // [0:38] is the sub-list for field type_name
-}
-
-func init() { file_services_mahjong_v1_mahjong_proto_init() }
-func file_services_mahjong_v1_mahjong_proto_init() {
-	if File_services_mahjong_v1_mahjong_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_services_mahjong_v1_mahjong_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2794,8 +9835,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginRequest); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaderboardReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2806,8 +9847,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginReply); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinQueueRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2818,8 +9859,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogoutReply); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueStatusReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2830,8 +9871,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReconnectInfo); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueMatchedReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2842,8 +9883,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerInfo); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2854,8 +9895,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Room); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveQueueReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2866,8 +9907,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRoomRequest); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeatAssignment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2878,8 +9919,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRoomReply); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeatDrawReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2890,8 +9931,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinRoomRequest); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionScoring); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2902,8 +9943,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinRoomReply); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2914,8 +9955,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshRoomRequest); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionMatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2926,8 +9967,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshRoomReply); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionStanding); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2938,8 +9979,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadyRequest); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2950,8 +9991,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadyReply); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionSummaryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2962,8 +10003,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartRequest); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionSummaryReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2974,8 +10015,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartReply); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2986,8 +10027,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveRoomRequest); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentStanding); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2998,8 +10039,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddRobotRequest); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tournament); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3010,8 +10051,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemovePlayerRequest); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTournamentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3022,8 +10063,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Action); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3034,8 +10075,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameInfo); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3046,8 +10087,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DrawMsg); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTournamentsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3058,8 +10099,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiscardMsg); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Announcement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3070,8 +10111,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CallMsg); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminClient); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3082,8 +10123,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetReadyReply); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClientsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3094,8 +10135,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelReadyReply); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSeat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3106,8 +10147,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddRobotReply); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRoom); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3118,8 +10159,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerJoinReply); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoomsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3130,8 +10171,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerLeaveReply); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminKickRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3142,8 +10183,8 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChatRequest); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminPlayerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3154,8 +10195,68 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 				return nil
 			}
 		}
-		file_services_mahjong_v1_mahjong_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChatReply); i {
+		file_services_mahjong_v1_mahjong_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnounceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceEndGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_mahjong_v1_mahjong_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3167,9 +10268,19 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 			}
 		}
 	}
-	file_services_mahjong_v1_mahjong_proto_msgTypes[2].OneofWrappers = []interface{}{}
-	file_services_mahjong_v1_mahjong_proto_msgTypes[11].OneofWrappers = []interface{}{}
-	file_services_mahjong_v1_mahjong_proto_msgTypes[13].OneofWrappers = []interface{}{
+	file_services_mahjong_v1_mahjong_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_services_mahjong_v1_mahjong_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_services_mahjong_v1_mahjong_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_services_mahjong_v1_mahjong_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_services_mahjong_v1_mahjong_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_services_mahjong_v1_mahjong_proto_msgTypes[20].OneofWrappers = []interface{}{
+		(*LobbyReply_Snapshot)(nil),
+		(*LobbyReply_RoomCreated)(nil),
+		(*LobbyReply_PlayerCountChanged)(nil),
+		(*LobbyReply_StatusChanged)(nil),
+		(*LobbyReply_RoomClosed)(nil),
+	}
+	file_services_mahjong_v1_mahjong_proto_msgTypes[23].OneofWrappers = []interface{}{
 		(*ReadyRequest_GetReady)(nil),
 		(*ReadyRequest_CancelReady)(nil),
 		(*ReadyRequest_AddRobot)(nil),
@@ -3177,8 +10288,13 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 		(*ReadyRequest_LeaveRoom)(nil),
 		(*ReadyRequest_StartGame)(nil),
 		(*ReadyRequest_Chat)(nil),
+		(*ReadyRequest_SwapSeats)(nil),
+		(*ReadyRequest_MoveSeat)(nil),
+		(*ReadyRequest_KickPlayer)(nil),
+		(*ReadyRequest_TransferOwner)(nil),
+		(*ReadyRequest_Rematch)(nil),
 	}
-	file_services_mahjong_v1_mahjong_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_services_mahjong_v1_mahjong_proto_msgTypes[24].OneofWrappers = []interface{}{
 		(*ReadyReply_PlayerJoin)(nil),
 		(*ReadyReply_GetReady)(nil),
 		(*ReadyReply_CancelReady)(nil),
@@ -3186,14 +10302,20 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 		(*ReadyReply_PlayerLeave)(nil),
 		(*ReadyReply_StartGame)(nil),
 		(*ReadyReply_Chat)(nil),
-	}
-	file_services_mahjong_v1_mahjong_proto_msgTypes[15].OneofWrappers = []interface{}{
+		(*ReadyReply_SwapSeats)(nil),
+		(*ReadyReply_MoveSeat)(nil),
+		(*ReadyReply_KickPlayer)(nil),
+		(*ReadyReply_TransferOwner)(nil),
+		(*ReadyReply_Rematch)(nil),
+		(*ReadyReply_Announcement)(nil),
+	}
+	file_services_mahjong_v1_mahjong_proto_msgTypes[25].OneofWrappers = []interface{}{
 		(*StartRequest_Ping)(nil),
 		(*StartRequest_Action)(nil),
 		(*StartRequest_Next)(nil),
 		(*StartRequest_Chat)(nil),
 	}
-	file_services_mahjong_v1_mahjong_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_services_mahjong_v1_mahjong_proto_msgTypes[26].OneofWrappers = []interface{}{
 		(*StartReply_Pong)(nil),
 		(*StartReply_Draw)(nil),
 		(*StartReply_Discard)(nil),
@@ -3201,18 +10323,30 @@ func file_services_mahjong_v1_mahjong_proto_init() {
 		(*StartReply_GameStart)(nil),
 		(*StartReply_GameInitInfo)(nil),
 		(*StartReply_Chat)(nil),
-	}
-	file_services_mahjong_v1_mahjong_proto_msgTypes[22].OneofWrappers = []interface{}{}
-	file_services_mahjong_v1_mahjong_proto_msgTypes[24].OneofWrappers = []interface{}{}
+		(*StartReply_SeatDraw)(nil),
+		(*StartReply_Announcement)(nil),
+	}
+	file_services_mahjong_v1_mahjong_proto_msgTypes[37].OneofWrappers = []interface{}{}
+	file_services_mahjong_v1_mahjong_proto_msgTypes[39].OneofWrappers = []interface{}{}
+	file_services_mahjong_v1_mahjong_proto_msgTypes[58].OneofWrappers = []interface{}{}
+	file_services_mahjong_v1_mahjong_proto_msgTypes[61].OneofWrappers = []interface{}{}
+	file_services_mahjong_v1_mahjong_proto_msgTypes[64].OneofWrappers = []interface{}{}
+	file_services_mahjong_v1_mahjong_proto_msgTypes[67].OneofWrappers = []interface{}{
+		(*QueueReply_Status)(nil),
+		(*QueueReply_Matched)(nil),
+		(*QueueReply_Left)(nil),
+	}
+	file_services_mahjong_v1_mahjong_proto_msgTypes[81].OneofWrappers = []interface{}{}
+	file_services_mahjong_v1_mahjong_proto_msgTypes[97].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_services_mahjong_v1_mahjong_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   32,
+			NumEnums:      10,
+			NumMessages:   99,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_services_mahjong_v1_mahjong_proto_goTypes,
 		DependencyIndexes: file_services_mahjong_v1_mahjong_proto_depIdxs,
@@ -23,13 +23,31 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MahjongClient interface {
 	Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	// tells the server the protocol and features of the client before logging in,
+	// fails with FailedPrecondition and what to do if the server can't serve the client
+	Hello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloReply, error)
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterReply, error)
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginReply, error)
+	RefreshToken(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RefreshTokenReply, error)
 	Logout(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LogoutReply, error)
 	CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomReply, error)
 	JoinRoom(ctx context.Context, in *JoinRoomRequest, opts ...grpc.CallOption) (*JoinRoomReply, error)
 	RefreshRoom(ctx context.Context, in *RefreshRoomRequest, opts ...grpc.CallOption) (*RefreshRoomReply, error)
+	// sends the public rooms first, then every room created, filled, started or closed
+	WatchLobby(ctx context.Context, in *WatchLobbyRequest, opts ...grpc.CallOption) (Mahjong_WatchLobbyClient, error)
 	Ready(ctx context.Context, opts ...grpc.CallOption) (Mahjong_ReadyClient, error)
 	Start(ctx context.Context, opts ...grpc.CallOption) (Mahjong_StartClient, error)
+	GetProfile(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetProfileReply, error)
+	GetMatchHistory(ctx context.Context, in *GetMatchHistoryRequest, opts ...grpc.CallOption) (*GetMatchHistoryReply, error)
+	GetPlayerStats(ctx context.Context, in *GetPlayerStatsRequest, opts ...grpc.CallOption) (*GetPlayerStatsReply, error)
+	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardReply, error)
+	JoinQueue(ctx context.Context, in *JoinQueueRequest, opts ...grpc.CallOption) (Mahjong_JoinQueueClient, error)
+	LeaveQueue(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LeaveQueueReply, error)
+	GetSessionSummary(ctx context.Context, in *GetSessionSummaryRequest, opts ...grpc.CallOption) (*GetSessionSummaryReply, error)
+	ListTournaments(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListTournamentsReply, error)
+	GetTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*TournamentReply, error)
+	RegisterTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*TournamentReply, error)
+	LeaveTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*TournamentReply, error)
 }
 
 type mahjongClient struct {
@@ -49,6 +67,24 @@ func (c *mahjongClient) Ping(ctx context.Context, in *Empty, opts ...grpc.CallOp
 	return out, nil
 }
 
+func (c *mahjongClient) Hello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloReply, error) {
+	out := new(HelloReply)
+	err := c.cc.Invoke(ctx, "/mahjong.Mahjong/Hello", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterReply, error) {
+	out := new(RegisterReply)
+	err := c.cc.Invoke(ctx, "/mahjong.Mahjong/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mahjongClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginReply, error) {
 	out := new(LoginReply)
 	err := c.cc.Invoke(ctx, "/mahjong.Mahjong/Login", in, out, opts...)
@@ -58,6 +94,15 @@ func (c *mahjongClient) Login(ctx context.Context, in *LoginRequest, opts ...grp
 	return out, nil
 }
 
+func (c *mahjongClient) RefreshToken(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RefreshTokenReply, error) {
+	out := new(RefreshTokenReply)
+	err := c.cc.Invoke(ctx, "/mahjong.Mahjong/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mahjongClient) Logout(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LogoutReply, error) {
 	out := new(LogoutReply)
 	err := c.cc.Invoke(ctx, "/mahjong.Mahjong/Logout", in, out, opts...)
@@ -94,8 +139,40 @@ func (c *mahjongClient) RefreshRoom(ctx context.Context, in *RefreshRoomRequest,
 	return out, nil
 }
 
+func (c *mahjongClient) WatchLobby(ctx context.Context, in *WatchLobbyRequest, opts ...grpc.CallOption) (Mahjong_WatchLobbyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Mahjong_ServiceDesc.Streams[0], "/mahjong.Mahjong/WatchLobby", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mahjongWatchLobbyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Mahjong_WatchLobbyClient interface {
+	Recv() (*LobbyReply, error)
+	grpc.ClientStream
+}
+
+type mahjongWatchLobbyClient struct {
+	grpc.ClientStream
+}
+
+func (x *mahjongWatchLobbyClient) Recv() (*LobbyReply, error) {
+	m := new(LobbyReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *mahjongClient) Ready(ctx context.Context, opts ...grpc.CallOption) (Mahjong_ReadyClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Mahjong_ServiceDesc.Streams[0], "/mahjong.Mahjong/Ready", opts...)
+	stream, err := c.cc.NewStream(ctx, &Mahjong_ServiceDesc.Streams[1], "/mahjong.Mahjong/Ready", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +203,7 @@ func (x *mahjongReadyClient) Recv() (*ReadyReply, error) {
 }
 
 func (c *mahjongClient) Start(ctx context.Context, opts ...grpc.CallOption) (Mahjong_StartClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Mahjong_ServiceDesc.Streams[1], "/mahjong.Mahjong/Start", opts...)
+	stream, err := c.cc.NewStream(ctx, &Mahjong_ServiceDesc.Streams[2], "/mahjong.Mahjong/Start", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -156,18 +233,158 @@ func (x *mahjongStartClient) Recv() (*StartReply, error) {
 	return m, nil
 }
 
+func (c *mahjongClient) GetProfile(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetProfileReply, error) {
+	out := new(GetProfileReply)
+	err := c.cc.Invoke(ctx, "/mahjong.Mahjong/GetProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongClient) GetMatchHistory(ctx context.Context, in *GetMatchHistoryRequest, opts ...grpc.CallOption) (*GetMatchHistoryReply, error) {
+	out := new(GetMatchHistoryReply)
+	err := c.cc.Invoke(ctx, "/mahjong.Mahjong/GetMatchHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongClient) GetPlayerStats(ctx context.Context, in *GetPlayerStatsRequest, opts ...grpc.CallOption) (*GetPlayerStatsReply, error) {
+	out := new(GetPlayerStatsReply)
+	err := c.cc.Invoke(ctx, "/mahjong.Mahjong/GetPlayerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardReply, error) {
+	out := new(GetLeaderboardReply)
+	err := c.cc.Invoke(ctx, "/mahjong.Mahjong/GetLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongClient) JoinQueue(ctx context.Context, in *JoinQueueRequest, opts ...grpc.CallOption) (Mahjong_JoinQueueClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Mahjong_ServiceDesc.Streams[3], "/mahjong.Mahjong/JoinQueue", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mahjongJoinQueueClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Mahjong_JoinQueueClient interface {
+	Recv() (*QueueReply, error)
+	grpc.ClientStream
+}
+
+type mahjongJoinQueueClient struct {
+	grpc.ClientStream
+}
+
+func (x *mahjongJoinQueueClient) Recv() (*QueueReply, error) {
+	m := new(QueueReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mahjongClient) LeaveQueue(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LeaveQueueReply, error) {
+	out := new(LeaveQueueReply)
+	err := c.cc.Invoke(ctx, "/mahjong.Mahjong/LeaveQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongClient) GetSessionSummary(ctx context.Context, in *GetSessionSummaryRequest, opts ...grpc.CallOption) (*GetSessionSummaryReply, error) {
+	out := new(GetSessionSummaryReply)
+	err := c.cc.Invoke(ctx, "/mahjong.Mahjong/GetSessionSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongClient) ListTournaments(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListTournamentsReply, error) {
+	out := new(ListTournamentsReply)
+	err := c.cc.Invoke(ctx, "/mahjong.Mahjong/ListTournaments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongClient) GetTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*TournamentReply, error) {
+	out := new(TournamentReply)
+	err := c.cc.Invoke(ctx, "/mahjong.Mahjong/GetTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongClient) RegisterTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*TournamentReply, error) {
+	out := new(TournamentReply)
+	err := c.cc.Invoke(ctx, "/mahjong.Mahjong/RegisterTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongClient) LeaveTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*TournamentReply, error) {
+	out := new(TournamentReply)
+	err := c.cc.Invoke(ctx, "/mahjong.Mahjong/LeaveTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MahjongServer is the server API for Mahjong service.
 // All implementations must embed UnimplementedMahjongServer
 // for forward compatibility
 type MahjongServer interface {
 	Ping(context.Context, *Empty) (*Empty, error)
+	// tells the server the protocol and features of the client before logging in,
+	// fails with FailedPrecondition and what to do if the server can't serve the client
+	Hello(context.Context, *HelloRequest) (*HelloReply, error)
+	Register(context.Context, *RegisterRequest) (*RegisterReply, error)
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
+	RefreshToken(context.Context, *Empty) (*RefreshTokenReply, error)
 	Logout(context.Context, *Empty) (*LogoutReply, error)
 	CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomReply, error)
 	JoinRoom(context.Context, *JoinRoomRequest) (*JoinRoomReply, error)
 	RefreshRoom(context.Context, *RefreshRoomRequest) (*RefreshRoomReply, error)
+	// sends the public rooms first, then every room created, filled, started or closed
+	WatchLobby(*WatchLobbyRequest, Mahjong_WatchLobbyServer) error
 	Ready(Mahjong_ReadyServer) error
 	Start(Mahjong_StartServer) error
+	GetProfile(context.Context, *Empty) (*GetProfileReply, error)
+	GetMatchHistory(context.Context, *GetMatchHistoryRequest) (*GetMatchHistoryReply, error)
+	GetPlayerStats(context.Context, *GetPlayerStatsRequest) (*GetPlayerStatsReply, error)
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardReply, error)
+	JoinQueue(*JoinQueueRequest, Mahjong_JoinQueueServer) error
+	LeaveQueue(context.Context, *Empty) (*LeaveQueueReply, error)
+	GetSessionSummary(context.Context, *GetSessionSummaryRequest) (*GetSessionSummaryReply, error)
+	ListTournaments(context.Context, *Empty) (*ListTournamentsReply, error)
+	GetTournament(context.Context, *TournamentRequest) (*TournamentReply, error)
+	RegisterTournament(context.Context, *TournamentRequest) (*TournamentReply, error)
+	LeaveTournament(context.Context, *TournamentRequest) (*TournamentReply, error)
 	mustEmbedUnimplementedMahjongServer()
 }
 
@@ -178,9 +395,18 @@ type UnimplementedMahjongServer struct {
 func (UnimplementedMahjongServer) Ping(context.Context, *Empty) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
 }
+func (UnimplementedMahjongServer) Hello(context.Context, *HelloRequest) (*HelloReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hello not implemented")
+}
+func (UnimplementedMahjongServer) Register(context.Context, *RegisterRequest) (*RegisterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
 func (UnimplementedMahjongServer) Login(context.Context, *LoginRequest) (*LoginReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
+func (UnimplementedMahjongServer) RefreshToken(context.Context, *Empty) (*RefreshTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
 func (UnimplementedMahjongServer) Logout(context.Context, *Empty) (*LogoutReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
 }
@@ -193,12 +419,48 @@ func (UnimplementedMahjongServer) JoinRoom(context.Context, *JoinRoomRequest) (*
 func (UnimplementedMahjongServer) RefreshRoom(context.Context, *RefreshRoomRequest) (*RefreshRoomReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshRoom not implemented")
 }
+func (UnimplementedMahjongServer) WatchLobby(*WatchLobbyRequest, Mahjong_WatchLobbyServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchLobby not implemented")
+}
 func (UnimplementedMahjongServer) Ready(Mahjong_ReadyServer) error {
 	return status.Errorf(codes.Unimplemented, "method Ready not implemented")
 }
 func (UnimplementedMahjongServer) Start(Mahjong_StartServer) error {
 	return status.Errorf(codes.Unimplemented, "method Start not implemented")
 }
+func (UnimplementedMahjongServer) GetProfile(context.Context, *Empty) (*GetProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedMahjongServer) GetMatchHistory(context.Context, *GetMatchHistoryRequest) (*GetMatchHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatchHistory not implemented")
+}
+func (UnimplementedMahjongServer) GetPlayerStats(context.Context, *GetPlayerStatsRequest) (*GetPlayerStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerStats not implemented")
+}
+func (UnimplementedMahjongServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedMahjongServer) JoinQueue(*JoinQueueRequest, Mahjong_JoinQueueServer) error {
+	return status.Errorf(codes.Unimplemented, "method JoinQueue not implemented")
+}
+func (UnimplementedMahjongServer) LeaveQueue(context.Context, *Empty) (*LeaveQueueReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveQueue not implemented")
+}
+func (UnimplementedMahjongServer) GetSessionSummary(context.Context, *GetSessionSummaryRequest) (*GetSessionSummaryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionSummary not implemented")
+}
+func (UnimplementedMahjongServer) ListTournaments(context.Context, *Empty) (*ListTournamentsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTournaments not implemented")
+}
+func (UnimplementedMahjongServer) GetTournament(context.Context, *TournamentRequest) (*TournamentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTournament not implemented")
+}
+func (UnimplementedMahjongServer) RegisterTournament(context.Context, *TournamentRequest) (*TournamentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterTournament not implemented")
+}
+func (UnimplementedMahjongServer) LeaveTournament(context.Context, *TournamentRequest) (*TournamentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveTournament not implemented")
+}
 func (UnimplementedMahjongServer) mustEmbedUnimplementedMahjongServer() {}
 
 // UnsafeMahjongServer may be embedded to opt out of forward compatibility for this service.
@@ -230,6 +492,42 @@ func _Mahjong_Ping_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mahjong_Hello_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HelloRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongServer).Hello(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.Mahjong/Hello",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongServer).Hello(ctx, req.(*HelloRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mahjong_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.Mahjong/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mahjong_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LoginRequest)
 	if err := dec(in); err != nil {
@@ -248,6 +546,24 @@ func _Mahjong_Login_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mahjong_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.Mahjong/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongServer).RefreshToken(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mahjong_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -320,6 +636,27 @@ func _Mahjong_RefreshRoom_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mahjong_WatchLobby_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchLobbyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MahjongServer).WatchLobby(m, &mahjongWatchLobbyServer{stream})
+}
+
+type Mahjong_WatchLobbyServer interface {
+	Send(*LobbyReply) error
+	grpc.ServerStream
+}
+
+type mahjongWatchLobbyServer struct {
+	grpc.ServerStream
+}
+
+func (x *mahjongWatchLobbyServer) Send(m *LobbyReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Mahjong_Ready_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(MahjongServer).Ready(&mahjongReadyServer{stream})
 }
@@ -372,51 +709,806 @@ func (x *mahjongStartServer) Recv() (*StartRequest, error) {
 	return m, nil
 }
 
-// Mahjong_ServiceDesc is the grpc.ServiceDesc for Mahjong service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Mahjong_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "mahjong.Mahjong",
-	HandlerType: (*MahjongServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Ping",
-			Handler:    _Mahjong_Ping_Handler,
-		},
-		{
-			MethodName: "Login",
-			Handler:    _Mahjong_Login_Handler,
-		},
-		{
-			MethodName: "Logout",
-			Handler:    _Mahjong_Logout_Handler,
-		},
-		{
-			MethodName: "CreateRoom",
-			Handler:    _Mahjong_CreateRoom_Handler,
-		},
-		{
-			MethodName: "JoinRoom",
-			Handler:    _Mahjong_JoinRoom_Handler,
-		},
-		{
-			MethodName: "RefreshRoom",
-			Handler:    _Mahjong_RefreshRoom_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Ready",
-			Handler:       _Mahjong_Ready_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "Start",
-			Handler:       _Mahjong_Start_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
+func _Mahjong_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.Mahjong/GetProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongServer).GetProfile(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mahjong_GetMatchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongServer).GetMatchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.Mahjong/GetMatchHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongServer).GetMatchHistory(ctx, req.(*GetMatchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mahjong_GetPlayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongServer).GetPlayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.Mahjong/GetPlayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongServer).GetPlayerStats(ctx, req.(*GetPlayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mahjong_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.Mahjong/GetLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongServer).GetLeaderboard(ctx, req.(*GetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mahjong_JoinQueue_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JoinQueueRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MahjongServer).JoinQueue(m, &mahjongJoinQueueServer{stream})
+}
+
+type Mahjong_JoinQueueServer interface {
+	Send(*QueueReply) error
+	grpc.ServerStream
+}
+
+type mahjongJoinQueueServer struct {
+	grpc.ServerStream
+}
+
+func (x *mahjongJoinQueueServer) Send(m *QueueReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Mahjong_LeaveQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongServer).LeaveQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.Mahjong/LeaveQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongServer).LeaveQueue(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mahjong_GetSessionSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongServer).GetSessionSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.Mahjong/GetSessionSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongServer).GetSessionSummary(ctx, req.(*GetSessionSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mahjong_ListTournaments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongServer).ListTournaments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.Mahjong/ListTournaments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongServer).ListTournaments(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mahjong_GetTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongServer).GetTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.Mahjong/GetTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongServer).GetTournament(ctx, req.(*TournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mahjong_RegisterTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongServer).RegisterTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.Mahjong/RegisterTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongServer).RegisterTournament(ctx, req.(*TournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mahjong_LeaveTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongServer).LeaveTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.Mahjong/LeaveTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongServer).LeaveTournament(ctx, req.(*TournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Mahjong_ServiceDesc is the grpc.ServiceDesc for Mahjong service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Mahjong_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mahjong.Mahjong",
+	HandlerType: (*MahjongServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Ping",
+			Handler:    _Mahjong_Ping_Handler,
+		},
+		{
+			MethodName: "Hello",
+			Handler:    _Mahjong_Hello_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _Mahjong_Register_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _Mahjong_Login_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _Mahjong_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _Mahjong_Logout_Handler,
+		},
+		{
+			MethodName: "CreateRoom",
+			Handler:    _Mahjong_CreateRoom_Handler,
+		},
+		{
+			MethodName: "JoinRoom",
+			Handler:    _Mahjong_JoinRoom_Handler,
+		},
+		{
+			MethodName: "RefreshRoom",
+			Handler:    _Mahjong_RefreshRoom_Handler,
+		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _Mahjong_GetProfile_Handler,
+		},
+		{
+			MethodName: "GetMatchHistory",
+			Handler:    _Mahjong_GetMatchHistory_Handler,
+		},
+		{
+			MethodName: "GetPlayerStats",
+			Handler:    _Mahjong_GetPlayerStats_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _Mahjong_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "LeaveQueue",
+			Handler:    _Mahjong_LeaveQueue_Handler,
+		},
+		{
+			MethodName: "GetSessionSummary",
+			Handler:    _Mahjong_GetSessionSummary_Handler,
+		},
+		{
+			MethodName: "ListTournaments",
+			Handler:    _Mahjong_ListTournaments_Handler,
+		},
+		{
+			MethodName: "GetTournament",
+			Handler:    _Mahjong_GetTournament_Handler,
+		},
+		{
+			MethodName: "RegisterTournament",
+			Handler:    _Mahjong_RegisterTournament_Handler,
+		},
+		{
+			MethodName: "LeaveTournament",
+			Handler:    _Mahjong_LeaveTournament_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchLobby",
+			Handler:       _Mahjong_WatchLobby_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Ready",
+			Handler:       _Mahjong_Ready_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Start",
+			Handler:       _Mahjong_Start_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "JoinQueue",
+			Handler:       _Mahjong_JoinQueue_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "services/mahjong/v1/mahjong.proto",
+}
+
+// MahjongAdminClient is the client API for MahjongAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MahjongAdminClient interface {
+	ListClients(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListClientsReply, error)
+	ListRooms(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListRoomsReply, error)
+	// logs the player out, a ban also keeps the player from logging in again
+	KickPlayer(ctx context.Context, in *AdminKickRequest, opts ...grpc.CallOption) (*AdminReply, error)
+	UnbanPlayer(ctx context.Context, in *AdminPlayerRequest, opts ...grpc.CallOption) (*AdminReply, error)
+	CloseRoom(ctx context.Context, in *AdminRoomRequest, opts ...grpc.CallOption) (*AdminReply, error)
+	// sent on the Ready and Start streams of every online player
+	Announce(ctx context.Context, in *AnnounceRequest, opts ...grpc.CallOption) (*AdminReply, error)
+	// ends the running game of the room as if the engine finished it
+	ForceEndGame(ctx context.Context, in *ForceEndGameRequest, opts ...grpc.CallOption) (*AdminReply, error)
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelReply, error)
+	CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*TournamentReply, error)
+	// starts a registering tournament or resumes a paused one
+	StartTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*TournamentReply, error)
+	PauseTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*TournamentReply, error)
+	// pairs the current round again before any table finished
+	RepairTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*TournamentReply, error)
+}
+
+type mahjongAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMahjongAdminClient(cc grpc.ClientConnInterface) MahjongAdminClient {
+	return &mahjongAdminClient{cc}
+}
+
+func (c *mahjongAdminClient) ListClients(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListClientsReply, error) {
+	out := new(ListClientsReply)
+	err := c.cc.Invoke(ctx, "/mahjong.MahjongAdmin/ListClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAdminClient) ListRooms(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListRoomsReply, error) {
+	out := new(ListRoomsReply)
+	err := c.cc.Invoke(ctx, "/mahjong.MahjongAdmin/ListRooms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAdminClient) KickPlayer(ctx context.Context, in *AdminKickRequest, opts ...grpc.CallOption) (*AdminReply, error) {
+	out := new(AdminReply)
+	err := c.cc.Invoke(ctx, "/mahjong.MahjongAdmin/KickPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAdminClient) UnbanPlayer(ctx context.Context, in *AdminPlayerRequest, opts ...grpc.CallOption) (*AdminReply, error) {
+	out := new(AdminReply)
+	err := c.cc.Invoke(ctx, "/mahjong.MahjongAdmin/UnbanPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAdminClient) CloseRoom(ctx context.Context, in *AdminRoomRequest, opts ...grpc.CallOption) (*AdminReply, error) {
+	out := new(AdminReply)
+	err := c.cc.Invoke(ctx, "/mahjong.MahjongAdmin/CloseRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAdminClient) Announce(ctx context.Context, in *AnnounceRequest, opts ...grpc.CallOption) (*AdminReply, error) {
+	out := new(AdminReply)
+	err := c.cc.Invoke(ctx, "/mahjong.MahjongAdmin/Announce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAdminClient) ForceEndGame(ctx context.Context, in *ForceEndGameRequest, opts ...grpc.CallOption) (*AdminReply, error) {
+	out := new(AdminReply)
+	err := c.cc.Invoke(ctx, "/mahjong.MahjongAdmin/ForceEndGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAdminClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelReply, error) {
+	out := new(SetLogLevelReply)
+	err := c.cc.Invoke(ctx, "/mahjong.MahjongAdmin/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAdminClient) CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*TournamentReply, error) {
+	out := new(TournamentReply)
+	err := c.cc.Invoke(ctx, "/mahjong.MahjongAdmin/CreateTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAdminClient) StartTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*TournamentReply, error) {
+	out := new(TournamentReply)
+	err := c.cc.Invoke(ctx, "/mahjong.MahjongAdmin/StartTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAdminClient) PauseTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*TournamentReply, error) {
+	out := new(TournamentReply)
+	err := c.cc.Invoke(ctx, "/mahjong.MahjongAdmin/PauseTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAdminClient) RepairTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*TournamentReply, error) {
+	out := new(TournamentReply)
+	err := c.cc.Invoke(ctx, "/mahjong.MahjongAdmin/RepairTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MahjongAdminServer is the server API for MahjongAdmin service.
+// All implementations must embed UnimplementedMahjongAdminServer
+// for forward compatibility
+type MahjongAdminServer interface {
+	ListClients(context.Context, *Empty) (*ListClientsReply, error)
+	ListRooms(context.Context, *Empty) (*ListRoomsReply, error)
+	// logs the player out, a ban also keeps the player from logging in again
+	KickPlayer(context.Context, *AdminKickRequest) (*AdminReply, error)
+	UnbanPlayer(context.Context, *AdminPlayerRequest) (*AdminReply, error)
+	CloseRoom(context.Context, *AdminRoomRequest) (*AdminReply, error)
+	// sent on the Ready and Start streams of every online player
+	Announce(context.Context, *AnnounceRequest) (*AdminReply, error)
+	// ends the running game of the room as if the engine finished it
+	ForceEndGame(context.Context, *ForceEndGameRequest) (*AdminReply, error)
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelReply, error)
+	CreateTournament(context.Context, *CreateTournamentRequest) (*TournamentReply, error)
+	// starts a registering tournament or resumes a paused one
+	StartTournament(context.Context, *TournamentRequest) (*TournamentReply, error)
+	PauseTournament(context.Context, *TournamentRequest) (*TournamentReply, error)
+	// pairs the current round again before any table finished
+	RepairTournament(context.Context, *TournamentRequest) (*TournamentReply, error)
+	mustEmbedUnimplementedMahjongAdminServer()
+}
+
+// UnimplementedMahjongAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedMahjongAdminServer struct {
+}
+
+func (UnimplementedMahjongAdminServer) ListClients(context.Context, *Empty) (*ListClientsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
+}
+func (UnimplementedMahjongAdminServer) ListRooms(context.Context, *Empty) (*ListRoomsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRooms not implemented")
+}
+func (UnimplementedMahjongAdminServer) KickPlayer(context.Context, *AdminKickRequest) (*AdminReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickPlayer not implemented")
+}
+func (UnimplementedMahjongAdminServer) UnbanPlayer(context.Context, *AdminPlayerRequest) (*AdminReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanPlayer not implemented")
+}
+func (UnimplementedMahjongAdminServer) CloseRoom(context.Context, *AdminRoomRequest) (*AdminReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseRoom not implemented")
+}
+func (UnimplementedMahjongAdminServer) Announce(context.Context, *AnnounceRequest) (*AdminReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Announce not implemented")
+}
+func (UnimplementedMahjongAdminServer) ForceEndGame(context.Context, *ForceEndGameRequest) (*AdminReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceEndGame not implemented")
+}
+func (UnimplementedMahjongAdminServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedMahjongAdminServer) CreateTournament(context.Context, *CreateTournamentRequest) (*TournamentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTournament not implemented")
+}
+func (UnimplementedMahjongAdminServer) StartTournament(context.Context, *TournamentRequest) (*TournamentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTournament not implemented")
+}
+func (UnimplementedMahjongAdminServer) PauseTournament(context.Context, *TournamentRequest) (*TournamentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTournament not implemented")
+}
+func (UnimplementedMahjongAdminServer) RepairTournament(context.Context, *TournamentRequest) (*TournamentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairTournament not implemented")
+}
+func (UnimplementedMahjongAdminServer) mustEmbedUnimplementedMahjongAdminServer() {}
+
+// UnsafeMahjongAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MahjongAdminServer will
+// result in compilation errors.
+type UnsafeMahjongAdminServer interface {
+	mustEmbedUnimplementedMahjongAdminServer()
+}
+
+func RegisterMahjongAdminServer(s grpc.ServiceRegistrar, srv MahjongAdminServer) {
+	s.RegisterService(&MahjongAdmin_ServiceDesc, srv)
+}
+
+func _MahjongAdmin_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAdminServer).ListClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.MahjongAdmin/ListClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAdminServer).ListClients(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAdmin_ListRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAdminServer).ListRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.MahjongAdmin/ListRooms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAdminServer).ListRooms(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAdmin_KickPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminKickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAdminServer).KickPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.MahjongAdmin/KickPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAdminServer).KickPlayer(ctx, req.(*AdminKickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAdmin_UnbanPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAdminServer).UnbanPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.MahjongAdmin/UnbanPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAdminServer).UnbanPlayer(ctx, req.(*AdminPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAdmin_CloseRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAdminServer).CloseRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.MahjongAdmin/CloseRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAdminServer).CloseRoom(ctx, req.(*AdminRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAdmin_Announce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnounceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAdminServer).Announce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.MahjongAdmin/Announce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAdminServer).Announce(ctx, req.(*AnnounceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAdmin_ForceEndGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceEndGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAdminServer).ForceEndGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.MahjongAdmin/ForceEndGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAdminServer).ForceEndGame(ctx, req.(*ForceEndGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAdmin_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAdminServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.MahjongAdmin/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAdminServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAdmin_CreateTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAdminServer).CreateTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.MahjongAdmin/CreateTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAdminServer).CreateTournament(ctx, req.(*CreateTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAdmin_StartTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAdminServer).StartTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.MahjongAdmin/StartTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAdminServer).StartTournament(ctx, req.(*TournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAdmin_PauseTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAdminServer).PauseTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.MahjongAdmin/PauseTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAdminServer).PauseTournament(ctx, req.(*TournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAdmin_RepairTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAdminServer).RepairTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mahjong.MahjongAdmin/RepairTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAdminServer).RepairTournament(ctx, req.(*TournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MahjongAdmin_ServiceDesc is the grpc.ServiceDesc for MahjongAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MahjongAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mahjong.MahjongAdmin",
+	HandlerType: (*MahjongAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListClients",
+			Handler:    _MahjongAdmin_ListClients_Handler,
+		},
+		{
+			MethodName: "ListRooms",
+			Handler:    _MahjongAdmin_ListRooms_Handler,
+		},
+		{
+			MethodName: "KickPlayer",
+			Handler:    _MahjongAdmin_KickPlayer_Handler,
+		},
+		{
+			MethodName: "UnbanPlayer",
+			Handler:    _MahjongAdmin_UnbanPlayer_Handler,
+		},
+		{
+			MethodName: "CloseRoom",
+			Handler:    _MahjongAdmin_CloseRoom_Handler,
+		},
+		{
+			MethodName: "Announce",
+			Handler:    _MahjongAdmin_Announce_Handler,
+		},
+		{
+			MethodName: "ForceEndGame",
+			Handler:    _MahjongAdmin_ForceEndGame_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _MahjongAdmin_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "CreateTournament",
+			Handler:    _MahjongAdmin_CreateTournament_Handler,
+		},
+		{
+			MethodName: "StartTournament",
+			Handler:    _MahjongAdmin_StartTournament_Handler,
+		},
+		{
+			MethodName: "PauseTournament",
+			Handler:    _MahjongAdmin_PauseTournament_Handler,
+		},
+		{
+			MethodName: "RepairTournament",
+			Handler:    _MahjongAdmin_RepairTournament_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/mahjong/v1/mahjong.proto",
 }
@@ -0,0 +1,248 @@
+package storage
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/hphphp123321/mahjong-goserver/auth"
+	"github.com/hphphp123321/mahjong-goserver/rating"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	bolt "go.etcd.io/bbolt"
+	"time"
+)
+
+var (
+	usersBucket    = []byte("users")
+	profilesBucket = []byte("profiles")
+	matchesBucket  = []byte("matches")
+	// historyBucket has a sub bucket per player, keyed by finish time and match id
+	historyBucket = []byte("history")
+	// timelineBucket indexes every match by finish time and match id
+	timelineBucket = []byte("timeline")
+	sessionsBucket = []byte("sessions")
+)
+
+// BoltStore is a Store backed by an embedded BoltDB file
+type BoltStore struct {
+	db *bolt.DB
+}
+
+func OpenBoltStore(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, b := range [][]byte{usersBucket, profilesBucket, matchesBucket, historyBucket, timelineBucket, sessionsBucket} {
+			if _, err := tx.CreateBucketIfNotExists(b); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &BoltStore{db: db}, nil
+}
+
+func (s *BoltStore) Sync() error {
+	return s.db.Sync()
+}
+
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *BoltStore) GetUser(name string) (*auth.User, error) {
+	u := new(auth.User)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(usersBucket).Get([]byte(name))
+		if data == nil {
+			return auth.ErrUserNotFound
+		}
+		return json.Unmarshal(data, u)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (s *BoltStore) CreateUser(u *auth.User) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(usersBucket)
+		if b.Get([]byte(u.Name)) != nil {
+			return auth.ErrUserExists
+		}
+		return putJSON(b, []byte(u.Name), u)
+	})
+}
+
+func (s *BoltStore) GetProfile(name string) (*Profile, error) {
+	var p *Profile
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		p, err = getProfile(tx, name)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (s *BoltStore) TouchProfile(name string, loginAt time.Time) (*Profile, error) {
+	var p *Profile
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		var err error
+		p, err = getProfile(tx, name)
+		if err == ErrProfileNotFound {
+			p = &Profile{Name: name, CreatedAt: loginAt}
+		} else if err != nil {
+			return err
+		}
+		p.LastLoginAt = loginAt
+		return putJSON(tx.Bucket(profilesBucket), []byte(name), p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (s *BoltStore) SaveMatch(m *Match) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := putJSON(tx.Bucket(matchesBucket), m.MatchID[:], m); err != nil {
+			return err
+		}
+		if err := tx.Bucket(timelineBucket).Put(historyKey(m), m.MatchID[:]); err != nil {
+			return err
+		}
+		for _, mp := range m.Players {
+			if mp.Robot {
+				continue
+			}
+			p, err := getProfile(tx, mp.PlayerName)
+			if err == ErrProfileNotFound {
+				p = &Profile{Name: mp.PlayerName, CreatedAt: m.FinishedAt}
+			} else if err != nil {
+				return err
+			}
+			p.Aggregate.Matches++
+			p.Aggregate.TotalScore += mp.Score
+			p.Aggregate.Placements[mp.Placement-1]++
+			p.Aggregate.HandStats.Add(mp.HandStats)
+			if p.Ratings == nil {
+				p.Ratings = make(map[string]rating.State)
+			}
+			p.Ratings[m.RatingSystem] = mp.RatingAfter
+			if err = putJSON(tx.Bucket(profilesBucket), []byte(p.Name), p); err != nil {
+				return err
+			}
+			history, err := tx.Bucket(historyBucket).CreateBucketIfNotExists([]byte(mp.PlayerName))
+			if err != nil {
+				return err
+			}
+			if err = history.Put(historyKey(m), m.MatchID[:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *BoltStore) ListMatches(playerName string, limit int) ([]*Match, error) {
+	matches := make([]*Match, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		history := tx.Bucket(historyBucket).Bucket([]byte(playerName))
+		if history == nil {
+			return nil
+		}
+		c := history.Cursor()
+		for k, v := c.Last(); k != nil && (limit <= 0 || len(matches) < limit); k, v = c.Prev() {
+			m := new(Match)
+			if err := json.Unmarshal(tx.Bucket(matchesBucket).Get(v), m); err != nil {
+				return err
+			}
+			matches = append(matches, m)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+func (s *BoltStore) MatchesSince(since time.Time) ([]*Match, error) {
+	matches := make([]*Match, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		start := make([]byte, 8)
+		if !since.IsZero() {
+			binary.BigEndian.PutUint64(start, uint64(since.UnixNano()))
+		}
+		c := tx.Bucket(timelineBucket).Cursor()
+		for k, v := c.Seek(start); k != nil; k, v = c.Next() {
+			m := new(Match)
+			if err := json.Unmarshal(tx.Bucket(matchesBucket).Get(v), m); err != nil {
+				return err
+			}
+			matches = append(matches, m)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+func (s *BoltStore) SaveSession(session *room.Session) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return putJSON(tx.Bucket(sessionsBucket), session.RoomID[:], session)
+	})
+}
+
+func (s *BoltStore) GetSession(roomID uuid.UUID) (*room.Session, error) {
+	session := new(room.Session)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(sessionsBucket).Get(roomID[:])
+		if data == nil {
+			return ErrSessionNotFound
+		}
+		return json.Unmarshal(data, session)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func getProfile(tx *bolt.Tx, name string) (*Profile, error) {
+	data := tx.Bucket(profilesBucket).Get([]byte(name))
+	if data == nil {
+		return nil, ErrProfileNotFound
+	}
+	p := new(Profile)
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func putJSON(b *bolt.Bucket, key []byte, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return b.Put(key, data)
+}
+
+// historyKey sorts a player's matches by finish time
+func historyKey(m *Match) []byte {
+	key := make([]byte, 8, 8+len(m.MatchID))
+	binary.BigEndian.PutUint64(key, uint64(m.FinishedAt.UnixNano()))
+	return append(key, m.MatchID[:]...)
+}
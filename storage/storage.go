@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/hphphp123321/mahjong-goserver/auth"
+	"github.com/hphphp123321/mahjong-goserver/player"
+	"github.com/hphphp123321/mahjong-goserver/rating"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	"time"
+)
+
+var (
+	ErrProfileNotFound = errors.New("profile not found")
+	ErrSessionNotFound = errors.New("session not found")
+)
+
+// Store persists everything that should survive a server restart
+type Store interface {
+	auth.UserStore
+
+	GetProfile(name string) (*Profile, error)
+	// TouchProfile creates the profile if needed and records a login
+	TouchProfile(name string, loginAt time.Time) (*Profile, error)
+
+	// SaveMatch stores a finished match and updates the aggregates and ratings of every human player in it
+	SaveMatch(m *Match) error
+	// ListMatches returns the newest matches of a player first
+	ListMatches(playerName string, limit int) ([]*Match, error)
+	// MatchesSince returns every match finished at or after since, oldest first
+	MatchesSince(since time.Time) ([]*Match, error)
+
+	// SaveSession stores the session of a room, replacing the previous one
+	SaveSession(s *room.Session) error
+	GetSession(roomID uuid.UUID) (*room.Session, error)
+
+	// Sync flushes pending writes to disk
+	Sync() error
+	Close() error
+}
+
+type Profile struct {
+	Name        string    `json:"name"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastLoginAt time.Time `json:"last_login_at"`
+
+	Aggregate Aggregate `json:"aggregate"`
+	// Ratings is keyed by the name of the rating system
+	Ratings map[string]rating.State `json:"ratings"`
+}
+
+// Rating returns the state of the player in the system, or its initial state
+func (p *Profile) Rating(system rating.System) rating.State {
+	if s, ok := p.Ratings[system.Name()]; ok {
+		return s
+	}
+	return system.Initial()
+}
+
+// Aggregate is the running total of a player's finished matches
+type Aggregate struct {
+	Matches    int    `json:"matches"`
+	TotalScore int    `json:"total_score"`
+	Placements [4]int `json:"placements"` // how many times the player finished 1st, 2nd, 3rd and 4th
+
+	player.HandStats
+}
+
+func (a *Aggregate) AveragePlacement() float64 {
+	if a.Matches == 0 {
+		return 0
+	}
+	sum := 0
+	for i, n := range a.Placements {
+		sum += (i + 1) * n
+	}
+	return float64(sum) / float64(a.Matches)
+}
+
+type Match struct {
+	MatchID    uuid.UUID     `json:"match_id"`
+	RoomID     uuid.UUID     `json:"room_id"`
+	RoomName   string        `json:"room_name"`
+	RuleSet    string        `json:"rule_set"`
+	StartedAt  time.Time     `json:"started_at"`
+	FinishedAt time.Time     `json:"finished_at"`
+	Players    []MatchPlayer `json:"players"`
+	ReplayRef  string        `json:"replay_ref"`
+
+	RatingSystem string `json:"rating_system"`
+}
+
+type MatchPlayer struct {
+	PlayerName string `json:"player_name"`
+	Seat       int    `json:"seat"`
+	Robot      bool   `json:"robot"`
+	Score      int    `json:"score"`
+	Placement  int    `json:"placement"` // 1 to 4
+
+	player.HandStats
+	RatingBefore rating.State `json:"rating_before"`
+	RatingAfter  rating.State `json:"rating_after"`
+}
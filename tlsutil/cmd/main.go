@@ -0,0 +1,24 @@
+package main
+
+import (
+	"flag"
+	"github.com/hphphp123321/mahjong-goserver/tlsutil"
+	log "github.com/sirupsen/logrus"
+	"strings"
+	"time"
+)
+
+// generates a test CA with a server and a client certificate:
+// go run ./tlsutil/cmd -out certs -hosts localhost,127.0.0.1
+func main() {
+	out := flag.String("out", "certs", "directory the certificates are written to")
+	hosts := flag.String("hosts", "localhost,127.0.0.1", "comma separated host names and IPs of the server certificate")
+	clientName := flag.String("clientName", "robot", "common name of the client certificate")
+	days := flag.Int("days", 365, "days the certificates are valid")
+	flag.Parse()
+
+	if err := tlsutil.Generate(*out, strings.Split(*hosts, ","), *clientName, time.Duration(*days)*24*time.Hour); err != nil {
+		log.Fatalf("failed to generate certificates: %v", err)
+	}
+	log.Infof("certificates written to %s", *out)
+}
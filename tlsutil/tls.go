@@ -0,0 +1,73 @@
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+var ErrNoCertificates = errors.New("no PEM certificates found")
+
+// ServerConfig serves the certificate, client certificates signed by clientCAFile are verified when
+// it is set, and required when requireClientCert is also set
+func ServerConfig(certFile string, keyFile string, clientCAFile string, requireClientCert bool) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load server certificate: %w", err)
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAFile == "" {
+		if requireClientCert {
+			return nil, errors.New("client certificates can't be required without a client CA")
+		}
+		return cfg, nil
+	}
+	if cfg.ClientCAs, err = loadPool(clientCAFile); err != nil {
+		return nil, fmt.Errorf("load client CA: %w", err)
+	}
+	cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	if requireClientCert {
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return cfg, nil
+}
+
+// ClientConfig verifies the server with caFile, or the system roots if it is empty.
+// The client certificate is sent when certFile and keyFile are set
+func ClientConfig(caFile string, serverName string, certFile string, keyFile string) (*tls.Config, error) {
+	cfg := &tls.Config{
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	var err error
+	if caFile != "" {
+		if cfg.RootCAs, err = loadPool(caFile); err != nil {
+			return nil, fmt.Errorf("load CA: %w", err)
+		}
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+func loadPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("%s: %w", path, ErrNoCertificates)
+	}
+	return pool, nil
+}
@@ -0,0 +1,648 @@
+package tournament
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	ErrNotFound       = errors.New("tournament not found")
+	ErrRegistered     = errors.New("already registered")
+	ErrNotRegistered  = errors.New("not registered")
+	ErrWrongStatus    = errors.New("not allowed in the current tournament status")
+	ErrTooFewPlayers  = errors.New("not enough players to start")
+	ErrRoundStarted   = errors.New("the round already has results")
+	ErrTableNotFound  = errors.New("table not found")
+	ErrInvalidRounds  = errors.New("a tournament needs at least one round")
+	ErrUnknownPairing = errors.New("unknown pairing")
+)
+
+// MinPlayers is the smallest field a tournament starts with, robots fill the rest of the tables
+const MinPlayers = 2
+
+// Pairing decides how players are put at tables every round
+type Pairing int
+
+const (
+	// PairingSwiss spreads the seeds over the tables in the first round,
+	// later rounds put players with close standings together and avoid rematches
+	PairingSwiss Pairing = iota
+	// PairingSeeded spreads the standings over the tables every round so all tables are balanced
+	PairingSeeded
+)
+
+func (p Pairing) String() string {
+	switch p {
+	case PairingSwiss:
+		return "swiss"
+	case PairingSeeded:
+		return "seeded"
+	}
+	return fmt.Sprintf("Pairing(%d)", int(p))
+}
+
+// Status is the lifecycle stage of a tournament
+type Status int
+
+const (
+	StatusRegistering Status = iota // players register, nothing is paired
+	StatusRunning                   // rounds are paired and advance as soon as every table is done
+	StatusPaused                    // results are still recorded but the next round waits
+	StatusFinished                  // every round is done
+)
+
+// Entrant is a registered player and the totals over the rounds played
+type Entrant struct {
+	PlayerName string
+	Seed       float64 // rating.System Value at registration
+	Points     float64 // sum of uma-adjusted scores
+	Score      int     // sum of final points
+	Matches    int
+	Placements [4]int // count of 1st to 4th places
+	Dropped    bool   // dropped players are no longer paired
+
+	opponents map[string]int
+}
+
+// Table is one room of a round, Players are by seat and an empty name is a robot
+type Table struct {
+	Round   int
+	Number  int
+	RoomID  uuid.UUID
+	Players [4]string
+	Done    bool
+}
+
+func (t Table) has(playerName string) bool {
+	for _, name := range t.Players {
+		if name != "" && name == playerName {
+			return true
+		}
+	}
+	return false
+}
+
+// Robots returns the number of robot seats of the table
+func (t Table) Robots() int {
+	n := 0
+	for _, name := range t.Players {
+		if name == "" {
+			n++
+		}
+	}
+	return n
+}
+
+// Standing is the place of an entrant after the recorded results
+type Standing struct {
+	Rank int
+	Entrant
+}
+
+type Tournament struct {
+	ID         uuid.UUID
+	Name       string
+	Pairing    Pairing
+	Rounds     int
+	RuleSet    string
+	RobotLevel string
+	Scoring    room.Scoring
+	CreatedAt  time.Time
+
+	mu       sync.Mutex
+	status   Status
+	entrants map[string]*Entrant
+	order    []string // registration order
+	rounds   [][]*Table
+}
+
+func New(name string, pairing Pairing, rounds int) (*Tournament, error) {
+	if rounds < 1 {
+		return nil, ErrInvalidRounds
+	}
+	if pairing != PairingSwiss && pairing != PairingSeeded {
+		return nil, ErrUnknownPairing
+	}
+	return &Tournament{
+		ID:         uuid.New(),
+		Name:       name,
+		Pairing:    pairing,
+		Rounds:     rounds,
+		RobotLevel: "Simple",
+		Scoring:    room.DefaultScoring(),
+		CreatedAt:  time.Now(),
+		entrants:   make(map[string]*Entrant),
+	}, nil
+}
+
+func (t *Tournament) Status() Status {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.status
+}
+
+// Round returns the number of the current round, 0 before the start
+func (t *Tournament) Round() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.rounds)
+}
+
+// PlayerCount returns the number of players still in the tournament
+func (t *Tournament) PlayerCount() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.active())
+}
+
+// Register adds the player while the tournament is registering
+func (t *Tournament) Register(playerName string, seed float64) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.status != StatusRegistering {
+		return ErrWrongStatus
+	}
+	if _, ok := t.entrants[playerName]; ok {
+		return ErrRegistered
+	}
+	t.entrants[playerName] = &Entrant{
+		PlayerName: playerName,
+		Seed:       seed,
+		opponents:  make(map[string]int),
+	}
+	t.order = append(t.order, playerName)
+	return nil
+}
+
+// Leave removes the player before the start, later the player is dropped from the next pairings
+// and keeps the results already recorded
+func (t *Tournament) Leave(playerName string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	e, ok := t.entrants[playerName]
+	if !ok || e.Dropped {
+		return ErrNotRegistered
+	}
+	switch t.status {
+	case StatusRegistering:
+		delete(t.entrants, playerName)
+		for i, name := range t.order {
+			if name == playerName {
+				t.order = append(t.order[:i], t.order[i+1:]...)
+				break
+			}
+		}
+	case StatusFinished:
+		return ErrWrongStatus
+	default:
+		e.Dropped = true
+	}
+	return nil
+}
+
+// IsRegistered reports whether the player is still in the tournament
+func (t *Tournament) IsRegistered(playerName string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	e, ok := t.entrants[playerName]
+	return ok && !e.Dropped
+}
+
+// Start pairs the first round of a registering tournament or resumes a paused one,
+// the returned tables are new and need rooms, they are nil when nothing was paired
+func (t *Tournament) Start() ([]*Table, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	switch t.status {
+	case StatusRegistering:
+		if len(t.entrants) < MinPlayers {
+			return nil, ErrTooFewPlayers
+		}
+		t.status = StatusRunning
+		return t.pairNext(nil), nil
+	case StatusPaused:
+		t.status = StatusRunning
+		return t.advance(), nil
+	}
+	return nil, ErrWrongStatus
+}
+
+// Pause keeps the next round from being paired, tables already playing still record results
+func (t *Tournament) Pause() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.status != StatusRunning {
+		return ErrWrongStatus
+	}
+	t.status = StatusPaused
+	return nil
+}
+
+// Repair pairs the current round again, players with the same points are shuffled by the seed.
+// It returns the old tables, whose rooms have to be closed, and the new ones
+func (t *Tournament) Repair(seed int64) (old []*Table, tables []*Table, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.status != StatusRunning && t.status != StatusPaused {
+		return nil, nil, ErrWrongStatus
+	}
+	if len(t.rounds) == 0 {
+		return nil, nil, ErrWrongStatus
+	}
+	current := t.rounds[len(t.rounds)-1]
+	for _, table := range current {
+		if table.Done {
+			return nil, nil, ErrRoundStarted
+		}
+	}
+	if len(t.active()) < MinPlayers {
+		return nil, nil, ErrTooFewPlayers
+	}
+	t.rounds = t.rounds[:len(t.rounds)-1]
+	t.forget(current)
+	return copyTables(current), t.pairNext(rand.New(rand.NewSource(seed))), nil
+}
+
+// Record adds the result of the table played in the room, it returns the tables of the next round
+// when the result finished the round and the tournament is running
+func (t *Tournament) Record(roomID uuid.UUID, results []room.SessionResult) ([]*Table, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	table := t.tableByRoom(roomID)
+	if table == nil {
+		return nil, ErrTableNotFound
+	}
+	if table.Done {
+		return nil, ErrRoundStarted
+	}
+	table.Done = true
+	for _, res := range results {
+		if res.Robot || !table.has(res.PlayerName) || res.Placement < 1 || res.Placement > 4 {
+			continue
+		}
+		e, ok := t.entrants[res.PlayerName]
+		if !ok {
+			continue
+		}
+		e.Points += res.Adjusted
+		e.Score += res.Score
+		e.Matches++
+		e.Placements[res.Placement-1]++
+	}
+	if t.status != StatusRunning {
+		return nil, nil
+	}
+	return t.advance(), nil
+}
+
+// HasRoom reports whether the room is a table of the tournament
+func (t *Tournament) HasRoom(roomID uuid.UUID) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.tableByRoom(roomID) != nil
+}
+
+// Tables returns a copy of the tables of the current round
+func (t *Tournament) Tables() []*Table {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.rounds) == 0 {
+		return nil
+	}
+	return copyTables(t.rounds[len(t.rounds)-1])
+}
+
+// SetRoom links the table to the room created for it
+func (t *Tournament) SetRoom(round int, number int, roomID uuid.UUID) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if round < 1 || round > len(t.rounds) || number < 1 || number > len(t.rounds[round-1]) {
+		return ErrTableNotFound
+	}
+	t.rounds[round-1][number-1].RoomID = roomID
+	return nil
+}
+
+// Standings ranks the entrants by points, then total score, then 1st places,
+// then average placement and finally seed
+func (t *Tournament) Standings() []Standing {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	entrants := make([]*Entrant, 0, len(t.order))
+	for _, name := range t.order {
+		entrants = append(entrants, t.entrants[name])
+	}
+	sort.SliceStable(entrants, func(i, j int) bool {
+		return ranksBefore(entrants[i], entrants[j])
+	})
+	standings := make([]Standing, 0, len(entrants))
+	for i, e := range entrants {
+		s := Standing{Rank: i + 1, Entrant: *e}
+		s.opponents = nil
+		standings = append(standings, s)
+	}
+	return standings
+}
+
+func ranksBefore(a *Entrant, b *Entrant) bool {
+	if a.Points != b.Points {
+		return a.Points > b.Points
+	}
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	if a.Placements[0] != b.Placements[0] {
+		return a.Placements[0] > b.Placements[0]
+	}
+	if pa, pb := a.averagePlacement(), b.averagePlacement(); pa != pb {
+		return pa < pb
+	}
+	return a.Seed > b.Seed
+}
+
+func (e *Entrant) averagePlacement() float64 {
+	if e.Matches == 0 {
+		return 0
+	}
+	sum := 0
+	for i, n := range e.Placements {
+		sum += (i + 1) * n
+	}
+	return float64(sum) / float64(e.Matches)
+}
+
+// advance pairs the next round or finishes the tournament once every table of the round is done
+func (t *Tournament) advance() []*Table {
+	if len(t.rounds) > 0 {
+		for _, table := range t.rounds[len(t.rounds)-1] {
+			if !table.Done {
+				return nil
+			}
+		}
+	}
+	if len(t.rounds) >= t.Rounds || len(t.active()) < MinPlayers {
+		t.status = StatusFinished
+		return nil
+	}
+	return t.pairNext(nil)
+}
+
+// active returns the entrants that are still paired in registration order
+func (t *Tournament) active() []*Entrant {
+	entrants := make([]*Entrant, 0, len(t.order))
+	for _, name := range t.order {
+		if e := t.entrants[name]; !e.Dropped {
+			entrants = append(entrants, e)
+		}
+	}
+	return entrants
+}
+
+// pairNext adds the tables of the next round and returns a copy of them
+func (t *Tournament) pairNext(rng *rand.Rand) []*Table {
+	players := t.active()
+	if rng != nil {
+		rng.Shuffle(len(players), func(i, j int) {
+			players[i], players[j] = players[j], players[i]
+		})
+	}
+	round := len(t.rounds) + 1
+	switch {
+	case rng != nil && round == 1:
+		// the shuffle replaces the seeds
+	case rng != nil:
+		sort.SliceStable(players, func(i, j int) bool {
+			return players[i].Points > players[j].Points
+		})
+	case round == 1:
+		sort.SliceStable(players, func(i, j int) bool {
+			return players[i].Seed > players[j].Seed
+		})
+	default:
+		sort.SliceStable(players, func(i, j int) bool {
+			return ranksBefore(players[i], players[j])
+		})
+	}
+
+	// robots take the last seat of the last tables so no table has more than one
+	// unless there are fewer players than tables
+	count := (len(players) + 3) / 4
+	capacity := make([]int, count)
+	for i := range capacity {
+		capacity[i] = 4
+	}
+	for robots, i := count*4-len(players), count-1; robots > 0; robots-- {
+		capacity[i]--
+		if i--; i < 0 {
+			i = count - 1
+		}
+	}
+
+	var groups [][]*Entrant
+	if t.Pairing == PairingSwiss && round > 1 {
+		groups = swissGroups(players, capacity)
+	} else {
+		groups = snakeGroups(players, capacity)
+	}
+
+	tables := make([]*Table, 0, count)
+	for i, group := range groups {
+		table := &Table{Round: round, Number: i + 1}
+		for seat, e := range group {
+			table.Players[seat] = e.PlayerName
+			for _, o := range group {
+				if o != e {
+					e.opponents[o.PlayerName]++
+				}
+			}
+		}
+		tables = append(tables, table)
+	}
+	t.rounds = append(t.rounds, tables)
+	return copyTables(tables)
+}
+
+// forget removes the meetings of the tables from the opponents of their players
+func (t *Tournament) forget(tables []*Table) {
+	for _, table := range tables {
+		for _, name := range table.Players {
+			e, ok := t.entrants[name]
+			if !ok {
+				continue
+			}
+			for _, o := range table.Players {
+				if o != "" && o != name {
+					if e.opponents[o]--; e.opponents[o] <= 0 {
+						delete(e.opponents, o)
+					}
+				}
+			}
+		}
+	}
+}
+
+// snakeGroups deals the ordered players to the tables like 1-2-3-3-2-1,
+// so every table gets a strong and a weak player
+func snakeGroups(players []*Entrant, capacity []int) [][]*Entrant {
+	groups := make([][]*Entrant, len(capacity))
+	i, step := 0, 1
+	for _, e := range players {
+		for len(groups[i]) >= capacity[i] {
+			i, step = nextSnake(i, step, len(capacity))
+		}
+		groups[i] = append(groups[i], e)
+		i, step = nextSnake(i, step, len(capacity))
+	}
+	return groups
+}
+
+func nextSnake(i int, step int, n int) (int, int) {
+	if i+step < 0 || i+step >= n {
+		return i, -step
+	}
+	return i + step, step
+}
+
+// swissGroups seats the ordered players table by table, every seat goes to the best placed
+// remaining player that met the table the fewest times before
+func swissGroups(players []*Entrant, capacity []int) [][]*Entrant {
+	remaining := append([]*Entrant(nil), players...)
+	groups := make([][]*Entrant, len(capacity))
+	for i := range groups {
+		for len(groups[i]) < capacity[i] && len(remaining) > 0 {
+			best, bestMet := 0, -1
+			for j, e := range remaining {
+				met := 0
+				for _, o := range groups[i] {
+					met += e.opponents[o.PlayerName]
+				}
+				if bestMet < 0 || met < bestMet {
+					best, bestMet = j, met
+				}
+				if met == 0 {
+					break
+				}
+			}
+			groups[i] = append(groups[i], remaining[best])
+			remaining = append(remaining[:best], remaining[best+1:]...)
+		}
+	}
+	return groups
+}
+
+func (t *Tournament) tableByRoom(roomID uuid.UUID) *Table {
+	if roomID == uuid.Nil {
+		return nil
+	}
+	for _, tables := range t.rounds {
+		for _, table := range tables {
+			if table.RoomID == roomID {
+				return table
+			}
+		}
+	}
+	return nil
+}
+
+func copyTables(tables []*Table) []*Table {
+	c := make([]*Table, 0, len(tables))
+	for _, table := range tables {
+		tc := *table
+		c = append(c, &tc)
+	}
+	return c
+}
+
+// EntrantSnapshot is an entrant with the players met so far
+type EntrantSnapshot struct {
+	Entrant
+	Opponents map[string]int `json:"opponents,omitempty"`
+}
+
+// Snapshot is everything needed to rebuild a tournament after a restart
+type Snapshot struct {
+	ID         uuid.UUID         `json:"id"`
+	Name       string            `json:"name"`
+	Pairing    Pairing           `json:"pairing"`
+	Rounds     int               `json:"rounds"`
+	RuleSet    string            `json:"rule_set"`
+	RobotLevel string            `json:"robot_level"`
+	Scoring    room.Scoring      `json:"scoring"`
+	CreatedAt  time.Time         `json:"created_at"`
+	Status     Status            `json:"status"`
+	Entrants   []EntrantSnapshot `json:"entrants"` // registration order
+	Tables     [][]Table         `json:"tables"`   // by round
+}
+
+func (t *Tournament) Snapshot() Snapshot {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s := Snapshot{
+		ID:         t.ID,
+		Name:       t.Name,
+		Pairing:    t.Pairing,
+		Rounds:     t.Rounds,
+		RuleSet:    t.RuleSet,
+		RobotLevel: t.RobotLevel,
+		Scoring:    t.Scoring,
+		CreatedAt:  t.CreatedAt,
+		Status:     t.status,
+	}
+	for _, name := range t.order {
+		e := t.entrants[name]
+		es := EntrantSnapshot{Entrant: *e, Opponents: make(map[string]int, len(e.opponents))}
+		es.Entrant.opponents = nil
+		for o, n := range e.opponents {
+			es.Opponents[o] = n
+		}
+		s.Entrants = append(s.Entrants, es)
+	}
+	for _, tables := range t.rounds {
+		round := make([]Table, 0, len(tables))
+		for _, table := range tables {
+			round = append(round, *table)
+		}
+		s.Tables = append(s.Tables, round)
+	}
+	return s
+}
+
+// Restore rebuilds the tournament of the snapshot
+func Restore(s Snapshot) *Tournament {
+	t := &Tournament{
+		ID:         s.ID,
+		Name:       s.Name,
+		Pairing:    s.Pairing,
+		Rounds:     s.Rounds,
+		RuleSet:    s.RuleSet,
+		RobotLevel: s.RobotLevel,
+		Scoring:    s.Scoring,
+		CreatedAt:  s.CreatedAt,
+		status:     s.Status,
+		entrants:   make(map[string]*Entrant, len(s.Entrants)),
+	}
+	for _, es := range s.Entrants {
+		e := es.Entrant
+		e.opponents = make(map[string]int, len(es.Opponents))
+		for o, n := range es.Opponents {
+			e.opponents[o] = n
+		}
+		t.entrants[e.PlayerName] = &e
+		t.order = append(t.order, e.PlayerName)
+	}
+	for _, round := range s.Tables {
+		tables := make([]*Table, 0, len(round))
+		for i := range round {
+			table := round[i]
+			tables = append(tables, &table)
+		}
+		t.rounds = append(t.rounds, tables)
+	}
+	return t
+}
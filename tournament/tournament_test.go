@@ -0,0 +1,219 @@
+package tournament
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hphphp123321/mahjong-goserver/room"
+	"testing"
+)
+
+// newRunning registers the players with falling seeds and starts the tournament
+func newRunning(t *testing.T, pairing Pairing, rounds int, players int) (*Tournament, []*Table) {
+	t.Helper()
+	tr, err := New("cup", pairing, rounds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < players; i++ {
+		if err := tr.Register(fmt.Sprintf("p%02d", i), float64(players-i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tables, err := tr.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tr, tables
+}
+
+// playRound gives every table a room and records a result where the seats finish in order
+func playRound(t *testing.T, tr *Tournament, tables []*Table) []*Table {
+	t.Helper()
+	var next []*Table
+	for _, table := range tables {
+		roomID := uuid.New()
+		if err := tr.SetRoom(table.Round, table.Number, roomID); err != nil {
+			t.Fatal(err)
+		}
+		var results []room.SessionResult
+		for seat, name := range table.Players {
+			results = append(results, room.SessionResult{
+				PlayerName: name,
+				Seat:       seat,
+				Robot:      name == "",
+				Score:      40000 - seat*10000,
+				Placement:  seat + 1,
+				Adjusted:   float64(30 - seat*20),
+			})
+		}
+		tables, err := tr.Record(roomID, results)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tables != nil {
+			next = tables
+		}
+	}
+	return next
+}
+
+// meetings counts how often every pair of players shared a table
+func meetings(rounds ...[]*Table) map[[2]string]int {
+	met := make(map[[2]string]int)
+	for _, tables := range rounds {
+		for _, table := range tables {
+			for i, a := range table.Players {
+				for _, b := range table.Players[i+1:] {
+					if a == "" || b == "" {
+						continue
+					}
+					pair := [2]string{a, b}
+					if b < a {
+						pair = [2]string{b, a}
+					}
+					met[pair]++
+				}
+			}
+		}
+	}
+	return met
+}
+
+func TestSwissAvoidsRepeats(t *testing.T) {
+	tr, round1 := newRunning(t, PairingSwiss, 3, 16)
+	round2 := playRound(t, tr, round1)
+	if len(round2) != 4 {
+		t.Fatalf("round 2 has %d tables, want 4", len(round2))
+	}
+	for pair, n := range meetings(round1, round2) {
+		if n > 1 {
+			t.Errorf("%s and %s met %d times in two rounds", pair[0], pair[1], n)
+		}
+	}
+	// the winners of round 1 play each other
+	winners := map[string]bool{}
+	for _, table := range round1 {
+		winners[table.Players[0]] = true
+	}
+	for _, name := range round2[0].Players {
+		if !winners[name] {
+			t.Errorf("%s is at the top table of round 2 without winning in round 1", name)
+		}
+	}
+}
+
+func TestSwissGroupsPreferNewOpponents(t *testing.T) {
+	var players []*Entrant
+	for i := 0; i < 8; i++ {
+		players = append(players, &Entrant{PlayerName: fmt.Sprintf("p%d", i), opponents: map[string]int{}})
+	}
+	// p0 met p1 and p2 before, p1 met p2
+	for _, pair := range [][2]int{{0, 1}, {0, 2}, {1, 2}} {
+		players[pair[0]].opponents[players[pair[1]].PlayerName]++
+		players[pair[1]].opponents[players[pair[0]].PlayerName]++
+	}
+	groups := swissGroups(players, []int{4, 4})
+	want := [][]string{{"p0", "p3", "p4", "p5"}, {"p1", "p6", "p7", "p2"}}
+	for i, group := range groups {
+		var names []string
+		for _, e := range group {
+			names = append(names, e.PlayerName)
+		}
+		if fmt.Sprint(names) != fmt.Sprint(want[i]) {
+			t.Errorf("table %d = %v, want %v", i+1, names, want[i])
+		}
+	}
+}
+
+func TestSwissGroupsRepeatWhenUnavoidable(t *testing.T) {
+	var players []*Entrant
+	for i := 0; i < 4; i++ {
+		players = append(players, &Entrant{PlayerName: fmt.Sprintf("p%d", i), opponents: map[string]int{}})
+	}
+	players[0].opponents["p1"], players[1].opponents["p0"] = 1, 1
+	groups := swissGroups(players, []int{4})
+	if len(groups[0]) != 4 {
+		t.Fatalf("table has %d players, want everyone seated", len(groups[0]))
+	}
+}
+
+func TestRepairForgetsMeetings(t *testing.T) {
+	tr, round1 := newRunning(t, PairingSwiss, 3, 16)
+	playRound(t, tr, round1)
+	old, round2, err := tr.Repair(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(old) != 4 || len(round2) != 4 {
+		t.Fatalf("repair returned %d old and %d new tables, want 4 and 4", len(old), len(round2))
+	}
+	for pair, n := range meetings(round1, round2) {
+		if n > 1 {
+			t.Errorf("%s and %s met %d times after the repair", pair[0], pair[1], n)
+		}
+	}
+	for _, e := range tr.entrants {
+		total := 0
+		for _, n := range e.opponents {
+			total += n
+		}
+		if total != 6 {
+			t.Errorf("%s has %d meetings, want 6 from two rounds", e.PlayerName, total)
+		}
+	}
+}
+
+func TestRobotFillers(t *testing.T) {
+	tests := []struct {
+		players int
+		robots  []int // by table
+	}{
+		{2, []int{2}},
+		{5, []int{1, 2}},
+		{6, []int{1, 1}},
+		{7, []int{0, 1}},
+		{9, []int{1, 1, 1}},
+		{17, []int{0, 0, 1, 1, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.players), func(t *testing.T) {
+			_, tables := newRunning(t, PairingSeeded, 1, tt.players)
+			if len(tables) != len(tt.robots) {
+				t.Fatalf("%d tables, want %d", len(tables), len(tt.robots))
+			}
+			for i, table := range tables {
+				if got := table.Robots(); got != tt.robots[i] {
+					t.Errorf("table %d has %d robots, want %d", i+1, got, tt.robots[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFirstRoundIsSnakeSeeded(t *testing.T) {
+	_, tables := newRunning(t, PairingSwiss, 1, 8)
+	want := [][4]string{{"p00", "p03", "p04", "p07"}, {"p01", "p02", "p05", "p06"}}
+	for i, table := range tables {
+		if table.Players != want[i] {
+			t.Errorf("table %d = %v, want %v", i+1, table.Players, want[i])
+		}
+	}
+}
+
+func TestFinishesAfterLastRound(t *testing.T) {
+	tr, round1 := newRunning(t, PairingSwiss, 2, 4)
+	round2 := playRound(t, tr, round1)
+	if round2 == nil || tr.Round() != 2 {
+		t.Fatalf("round %d after the first round, want 2", tr.Round())
+	}
+	if next := playRound(t, tr, round2); next != nil {
+		t.Fatalf("a third round was paired: %v", next)
+	}
+	if tr.Status() != StatusFinished {
+		t.Fatalf("status %v, want finished", tr.Status())
+	}
+	standings := tr.Standings()
+	if standings[0].PlayerName != "p00" || standings[0].Placements[0] != 2 {
+		t.Errorf("leader %+v, want p00 with two wins", standings[0])
+	}
+}
@@ -0,0 +1,135 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"os"
+)
+
+const instrumentationName = "github.com/hphphp123321/mahjong-goserver"
+
+// span names of the game events, the engine starts the turn spans through the server
+const (
+	SpanGameStart       = "game.start"
+	SpanGameFinish      = "game.finish"
+	SpanDraw            = "game.draw"
+	SpanDecisionWait    = "game.decision_wait"
+	SpanCallArbitration = "game.call_arbitration"
+	SpanRobotDecision   = "robot.decision"
+)
+
+// attribute keys shared by the spans
+const (
+	RoomIDKey     = attribute.Key("mahjong.room_id")
+	MatchKey      = attribute.Key("mahjong.match") // matches played in the room session, counting this one
+	HandKey       = attribute.Key("mahjong.hand")
+	SeatKey       = attribute.Key("mahjong.seat")
+	PlayerNameKey = attribute.Key("mahjong.player_name")
+	RobotKey      = attribute.Key("mahjong.robot")
+)
+
+// Options choose where spans go, Exporter is none, stdout or file
+type Options struct {
+	ServiceName string
+	Exporter    string
+	File        string
+	SampleRatio float64
+}
+
+// Setup installs the global tracer provider, the returned function flushes and closes the exporter.
+// With the none exporter spans are not recorded at all
+func Setup(opts Options) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	var w io.Writer
+	var closer io.Closer
+	switch opts.Exporter {
+	case "none", "":
+		return func(context.Context) error { return nil }, nil
+	case "stdout":
+		w = os.Stdout
+	case "file":
+		f, err := os.OpenFile(opts.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+		if err != nil {
+			return nil, err
+		}
+		w, closer = f, f
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", opts.Exporter)
+	}
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(w))
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(opts.ServiceName)))
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closer != nil {
+			if cErr := closer.Close(); err == nil {
+				err = cErr
+			}
+		}
+		return err
+	}, nil
+}
+
+// Tracer returns the tracer of the server, spans are dropped until Setup installs an exporter
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Turn locates a game event, Hand and Seat are -1 when the event is not about one
+type Turn struct {
+	RoomID uuid.UUID
+	Hand   int
+	Seat   int
+}
+
+func (t Turn) Attributes() []attribute.KeyValue {
+	attrs := []attribute.KeyValue{RoomIDKey.String(t.RoomID.String())}
+	if t.Hand >= 0 {
+		attrs = append(attrs, HandKey.Int(t.Hand))
+	}
+	if t.Seat >= 0 {
+		attrs = append(attrs, SeatKey.Int(t.Seat))
+	}
+	return attrs
+}
+
+// StartTurn starts the span of a game event, like SpanDraw for the draw of a seat
+func StartTurn(ctx context.Context, name string, turn Turn) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(turn.Attributes()...))
+}
+
+// RoomTurn is the turn of a whole room, for events like the game start
+func RoomTurn(roomID uuid.UUID) Turn {
+	return Turn{RoomID: roomID, Hand: -1, Seat: -1}
+}
+
+// End ends the span, marking it failed if err is not nil
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}